@@ -0,0 +1,304 @@
+package bitbucket
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/codeEmitter/gitrob/common"
+)
+
+const (
+	//the largest page Bitbucket Server returns by default
+	pageLimit = 1000
+	//personal repositories live in a project keyed by the user's slug prefixed with a tilde
+	PersonalProjectPrefix = common.BitbucketPersonalProjectPrefix
+)
+
+type Client struct {
+	apiUrl       string
+	httpClient   *http.Client
+	IncludeForks bool
+	Logger       *common.Logger
+}
+
+type link struct {
+	Href string `json:"href"`
+	Name string `json:"name"`
+}
+
+type links struct {
+	Self  []link `json:"self"`
+	Clone []link `json:"clone"`
+}
+
+type project struct {
+	Key         string `json:"key"`
+	ID          int64  `json:"id"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Public      bool   `json:"public"`
+	Links       links  `json:"links"`
+}
+
+type user struct {
+	Name         string `json:"name"`
+	Slug         string `json:"slug"`
+	ID           int64  `json:"id"`
+	DisplayName  string `json:"displayName"`
+	EmailAddress string `json:"emailAddress"`
+	Links        links  `json:"links"`
+}
+
+type repository struct {
+	Slug        string      `json:"slug"`
+	ID          int64       `json:"id"`
+	Name        string      `json:"name"`
+	Description string      `json:"description"`
+	Public      bool        `json:"public"`
+	Archived    bool        `json:"archived"`
+	Project     project     `json:"project"`
+	Origin      *repository `json:"origin"`
+	Links       links       `json:"links"`
+}
+
+type branch struct {
+	DisplayID string `json:"displayId"`
+}
+
+type page struct {
+	IsLastPage    bool            `json:"isLastPage"`
+	NextPageStart int             `json:"nextPageStart"`
+	Values        json.RawMessage `json:"values"`
+}
+
+type errorResponse struct {
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+// the HTTP client authenticates requests and carries the rate limit handling shared with the other clients
+func (c Client) NewClient(apiUrl string, httpClient *http.Client) (apiClient Client, err error) {
+	if _, err := url.Parse(apiUrl); err != nil {
+		return c, err
+	}
+	c.apiUrl = strings.TrimSuffix(apiUrl, "/")
+	c.httpClient = httpClient
+	return c, nil
+}
+
+// authenticates an API request with a personal or HTTP access token
+func SetToken(req *http.Request, token string) {
+	req.Header.Set("Authorization", "Bearer "+token)
+}
+
+// targets are project keys or user slugs, where projects take precedence
+func (c Client) GetUserOrOrganization(login string) (*common.Owner, error) {
+	emptyString := common.String("")
+	var p project
+	status, projectErr := c.get(fmt.Sprintf("/projects/%s", url.PathEscape(login)), &p)
+	if projectErr == nil {
+		return &common.Owner{
+			Login:     common.String(p.Key),
+			ID:        &p.ID,
+			Type:      common.String(common.TargetTypeOrganization),
+			Name:      common.String(p.Name),
+			AvatarURL: emptyString,
+			URL:       common.String(selfLink(p.Links)),
+			Company:   emptyString,
+			Blog:      emptyString,
+			Location:  emptyString,
+			Email:     emptyString,
+			Bio:       common.String(p.Description),
+		}, nil
+	}
+	//only a missing project makes the target a user, other errors such as a rejected token are reported as is
+	if status != http.StatusNotFound {
+		return nil, projectErr
+	}
+	var u user
+	if _, err := c.get(fmt.Sprintf("/users/%s", url.PathEscape(login)), &u); err != nil {
+		return nil, errors.New(fmt.Sprintf("No Bitbucket project or user %s was found: %s", login, err))
+	}
+	return newUserOwner(u), nil
+}
+
+func newUserOwner(u user) *common.Owner {
+	emptyString := common.String("")
+	id := u.ID
+	return &common.Owner{
+		Login:     common.String(u.Slug),
+		ID:        &id,
+		Type:      common.String(common.TargetTypeUser),
+		Name:      common.String(u.DisplayName),
+		AvatarURL: emptyString,
+		URL:       common.String(selfLink(u.Links)),
+		Company:   emptyString,
+		Blog:      emptyString,
+		Location:  emptyString,
+		Email:     common.String(u.EmailAddress),
+		Bio:       emptyString,
+	}
+}
+
+// Bitbucket projects have no subgroups
+func (c Client) GetSubgroups(target common.Owner) ([]*common.Owner, error) {
+	return nil, nil
+}
+
+// the members of a project are the users granted a permission on it, which only project admins may list
+func (c Client) GetOrganizationMembers(target common.Owner) ([]*common.Owner, error) {
+	var allMembers []*common.Owner
+	err := c.getPages(fmt.Sprintf("/projects/%s/permissions/users", url.PathEscape(*target.Login)), func(values json.RawMessage) error {
+		var permissions []struct {
+			User user `json:"user"`
+		}
+		if err := json.Unmarshal(values, &permissions); err != nil {
+			return err
+		}
+		for _, permission := range permissions {
+			allMembers = append(allMembers, newUserOwner(permission.User))
+		}
+		return nil
+	})
+	return allMembers, err
+}
+
+func (c Client) GetRepositoriesFromOwner(target common.Owner) ([]*common.Repository, error) {
+	var allRepos []*common.Repository
+	key := *target.Login
+	if *target.Type == common.TargetTypeUser {
+		key = PersonalProjectPrefix + key
+	}
+	err := c.getPages(fmt.Sprintf("/projects/%s/repos", url.PathEscape(key)), func(values json.RawMessage) error {
+		var repos []repository
+		if err := json.Unmarshal(values, &repos); err != nil {
+			return err
+		}
+		for _, repo := range repos {
+			//don't capture forks unless asked to
+			if repo.Origin != nil && !c.IncludeForks {
+				continue
+			}
+			defaultBranch, err := c.getDefaultBranch(repo)
+			if err != nil {
+				c.Logger.Error(" Failed to retrieve the default branch of repository %s/%s, skipping it: %s\n", repo.Project.Key, repo.Slug, err)
+				continue
+			}
+			//empty repositories have no default branch and nothing to analyze
+			if defaultBranch == "" {
+				continue
+			}
+			allRepos = append(allRepos, newRepository(repo, defaultBranch))
+		}
+		return nil
+	})
+	return allRepos, err
+}
+
+func newRepository(repo repository, defaultBranch string) *common.Repository {
+	id := repo.ID
+	r := &common.Repository{
+		Owner:         common.String(repo.Project.Key),
+		ID:            &id,
+		Name:          common.String(repo.Slug),
+		FullName:      common.String(fmt.Sprintf("%s/%s", repo.Project.Key, repo.Slug)),
+		CloneURL:      common.String(cloneLink(repo.Links, "http")),
+		SSHCloneURL:   common.String(cloneLink(repo.Links, "ssh")),
+		URL:           common.String(selfLink(repo.Links)),
+		DefaultBranch: common.String(defaultBranch),
+		Description:   common.String(repo.Description),
+		Homepage:      common.String(selfLink(repo.Links)),
+		Fork:          common.Bool(repo.Origin != nil),
+		Visibility:    common.String(common.VisibilityPrivate),
+		Archived:      common.Bool(repo.Archived),
+	}
+	if repo.Public {
+		r.Visibility = common.String(common.VisibilityPublic)
+	}
+	if repo.Origin != nil {
+		r.ParentCloneURL = common.String(cloneLink(repo.Origin.Links, "http"))
+	}
+	return r
+}
+
+func (c Client) getDefaultBranch(repo repository) (string, error) {
+	var defaultBranch branch
+	path := fmt.Sprintf("/projects/%s/repos/%s/branches/default", url.PathEscape(repo.Project.Key), url.PathEscape(repo.Slug))
+	status, err := c.get(path, &defaultBranch)
+	if status == http.StatusNoContent || status == http.StatusNotFound {
+		return "", nil
+	}
+	return defaultBranch.DisplayID, err
+}
+
+// calls each with the values of every page of a paged resource
+func (c Client) getPages(path string, each func(values json.RawMessage) error) error {
+	start := 0
+	for {
+		var p page
+		if _, err := c.get(fmt.Sprintf("%s?start=%d&limit=%d", path, start, pageLimit), &p); err != nil {
+			return err
+		}
+		if err := each(p.Values); err != nil {
+			return err
+		}
+		if p.IsLastPage {
+			return nil
+		}
+		start = p.NextPageStart
+	}
+}
+
+// gets an API resource, returning the status code along with errors Bitbucket reports
+func (c Client) get(path string, result interface{}) (int, error) {
+	req, err := http.NewRequest("GET", c.apiUrl+path, nil)
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", common.UserAgent)
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return resp.StatusCode, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		var errs errorResponse
+		json.Unmarshal(body, &errs)
+		messages := []string{}
+		for _, e := range errs.Errors {
+			messages = append(messages, e.Message)
+		}
+		return resp.StatusCode, errors.New(fmt.Sprintf("GET %s: %d %s", c.apiUrl+path, resp.StatusCode, strings.Join(messages, " ")))
+	}
+	if resp.StatusCode == http.StatusNoContent {
+		return resp.StatusCode, nil
+	}
+	return resp.StatusCode, json.Unmarshal(body, result)
+}
+
+func selfLink(l links) string {
+	if len(l.Self) == 0 {
+		return ""
+	}
+	return l.Self[0].Href
+}
+
+func cloneLink(l links, name string) string {
+	for _, clone := range l.Clone {
+		if clone.Name == name {
+			return clone.Href
+		}
+	}
+	return ""
+}
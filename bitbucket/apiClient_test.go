@@ -0,0 +1,202 @@
+package bitbucket
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/codeEmitter/gitrob/common"
+)
+
+type fixture struct {
+	status int
+	body   string
+}
+
+// a Bitbucket Server stand-in answering requests for the paths and queries of its fixtures, and 404 otherwise
+func newTestClient(t *testing.T, includeForks bool, fixtures map[string]fixture) (Client, *[]string, func()) {
+	var requested []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		request := r.URL.Path
+		if r.URL.RawQuery != "" {
+			request += "?" + r.URL.RawQuery
+		}
+		requested = append(requested, request)
+		f, ok := fixtures[request]
+		if !ok {
+			f = fixture{http.StatusNotFound, `{"errors": [{"message": "Not found."}]}`}
+		}
+		if f.status != 0 {
+			w.WriteHeader(f.status)
+		}
+		fmt.Fprint(w, f.body)
+	}))
+	logger := &common.Logger{}
+	logger.SetSilent(true)
+	client, err := Client{IncludeForks: includeForks, Logger: logger}.NewClient(server.URL+"/", server.Client())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return client, &requested, server.Close
+}
+
+func repositoryJson(project string, slug string, id int, origin string) string {
+	links := fmt.Sprintf(`{"self": [{"href": "https://bitbucket.example.com/projects/%[1]s/repos/%[2]s/browse"}], "clone": [{"name": "http", "href": "https://bitbucket.example.com/scm/%[1]s/%[2]s.git"}, {"name": "ssh", "href": "ssh://git@bitbucket.example.com:7999/%[1]s/%[2]s.git"}]}`, strings.ToLower(project), slug)
+	if origin != "" {
+		origin = fmt.Sprintf(`, "origin": {"slug": "%[1]s", "project": {"key": "UPSTREAM"}, "links": {"clone": [{"name": "http", "href": "https://bitbucket.example.com/scm/upstream/%[1]s.git"}]}}`, origin)
+	}
+	return fmt.Sprintf(`{"slug": "%s", "id": %d, "name": "%s", "public": true, "project": {"key": "%s"}, "links": %s%s}`, slug, id, slug, project, links, origin)
+}
+
+func defaultBranch(project string, slug string, name string) (string, fixture) {
+	return fmt.Sprintf("/projects/%s/repos/%s/branches/default", project, slug), fixture{body: fmt.Sprintf(`{"displayId": "%s"}`, name)}
+}
+
+func repositoryNames(repos []*common.Repository) string {
+	var names []string
+	for _, repo := range repos {
+		names = append(names, *repo.FullName)
+	}
+	return strings.Join(names, ", ")
+}
+
+func TestGetUserOrOrganizationPrefersProjects(t *testing.T) {
+	client, requested, done := newTestClient(t, false, map[string]fixture{
+		"/projects/ACME": {body: `{"key": "ACME", "id": 1, "name": "Acme", "links": {"self": [{"href": "https://bitbucket.example.com/projects/ACME"}]}}`},
+		"/users/ACME":    {body: `{"slug": "acme", "id": 2}`},
+	})
+	defer done()
+	owner, err := client.GetUserOrOrganization("ACME")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *owner.Login != "ACME" || *owner.Type != common.TargetTypeOrganization || *owner.URL != "https://bitbucket.example.com/projects/ACME" {
+		t.Errorf("GetUserOrOrganization() = %s %s %s, want organization ACME", *owner.Type, *owner.Login, *owner.URL)
+	}
+	if len(*requested) != 1 {
+		t.Errorf("requested %q, want only the project", *requested)
+	}
+}
+
+func TestGetUserOrOrganizationFallsBackToUsers(t *testing.T) {
+	client, _, done := newTestClient(t, false, map[string]fixture{
+		"/users/alice": {body: `{"name": "alice", "slug": "alice", "id": 3, "displayName": "Alice", "emailAddress": "alice@example.com"}`},
+	})
+	defer done()
+	owner, err := client.GetUserOrOrganization("alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *owner.Login != "alice" || *owner.Type != common.TargetTypeUser || *owner.Email != "alice@example.com" {
+		t.Errorf("GetUserOrOrganization() = %s %s %s, want user alice", *owner.Type, *owner.Login, *owner.Email)
+	}
+}
+
+func TestGetUserOrOrganizationReportsOtherErrors(t *testing.T) {
+	client, requested, done := newTestClient(t, false, map[string]fixture{
+		"/projects/ACME": {http.StatusUnauthorized, `{"errors": [{"message": "Authentication failed."}]}`},
+		"/users/ACME":    {body: `{"slug": "acme", "id": 2}`},
+	})
+	defer done()
+	if _, err := client.GetUserOrOrganization("ACME"); err == nil || !strings.Contains(err.Error(), "Authentication failed.") {
+		t.Errorf("GetUserOrOrganization() error = %v, want the rejected token", err)
+	}
+	if len(*requested) != 1 {
+		t.Errorf("requested %q, want only the project", *requested)
+	}
+}
+
+func TestGetRepositoriesFromOwnerReadsEveryPage(t *testing.T) {
+	fixtures := map[string]fixture{
+		"/projects/ACME/repos?start=0&limit=1000": {body: fmt.Sprintf(`{"isLastPage": false, "nextPageStart": 2, "values": [%s, %s]}`,
+			repositoryJson("ACME", "alpha", 10, ""), repositoryJson("ACME", "beta", 11, ""))},
+		"/projects/ACME/repos?start=2&limit=1000": {body: fmt.Sprintf(`{"isLastPage": true, "values": [%s]}`,
+			repositoryJson("ACME", "gamma", 12, ""))},
+	}
+	for _, slug := range []string{"alpha", "beta", "gamma"} {
+		path, f := defaultBranch("ACME", slug, "main")
+		fixtures[path] = f
+	}
+	client, _, done := newTestClient(t, false, fixtures)
+	defer done()
+	repos, err := client.GetRepositoriesFromOwner(common.Owner{Login: common.String("ACME"), Type: common.String(common.TargetTypeOrganization)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if names := repositoryNames(repos); names != "ACME/alpha, ACME/beta, ACME/gamma" {
+		t.Errorf("GetRepositoriesFromOwner() = %s, want ACME/alpha, ACME/beta, ACME/gamma", names)
+	}
+	repo := repos[0]
+	if *repo.CloneURL != "https://bitbucket.example.com/scm/acme/alpha.git" || *repo.SSHCloneURL != "ssh://git@bitbucket.example.com:7999/acme/alpha.git" ||
+		*repo.DefaultBranch != "main" || *repo.Visibility != common.VisibilityPublic || *repo.Fork {
+		t.Errorf("GetRepositoriesFromOwner() = %s, %s, %s, %s, fork %t", *repo.CloneURL, *repo.SSHCloneURL, *repo.DefaultBranch, *repo.Visibility, *repo.Fork)
+	}
+}
+
+func TestGetRepositoriesFromOwnerListsPersonalProjectsOfUsers(t *testing.T) {
+	path, f := defaultBranch("~alice", "dotfiles", "master")
+	client, _, done := newTestClient(t, false, map[string]fixture{
+		"/projects/~alice/repos?start=0&limit=1000": {body: fmt.Sprintf(`{"isLastPage": true, "values": [%s]}`, repositoryJson("~alice", "dotfiles", 20, ""))},
+		path: f,
+	})
+	defer done()
+	repos, err := client.GetRepositoriesFromOwner(common.Owner{Login: common.String("alice"), Type: common.String(common.TargetTypeUser)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if names := repositoryNames(repos); names != "~alice/dotfiles" {
+		t.Errorf("GetRepositoriesFromOwner() = %s, want ~alice/dotfiles", names)
+	}
+}
+
+func TestGetRepositoriesFromOwnerSkipsForksUnlessIncluded(t *testing.T) {
+	fixtures := map[string]fixture{
+		"/projects/ACME/repos?start=0&limit=1000": {body: fmt.Sprintf(`{"isLastPage": true, "values": [%s, %s]}`,
+			repositoryJson("ACME", "alpha", 10, ""), repositoryJson("ACME", "fork", 11, "upstream"))},
+	}
+	for _, slug := range []string{"alpha", "fork"} {
+		path, f := defaultBranch("ACME", slug, "main")
+		fixtures[path] = f
+	}
+	owner := common.Owner{Login: common.String("ACME"), Type: common.String(common.TargetTypeOrganization)}
+	for _, includeForks := range []bool{false, true} {
+		client, _, done := newTestClient(t, includeForks, fixtures)
+		repos, err := client.GetRepositoriesFromOwner(owner)
+		done()
+		if err != nil {
+			t.Fatal(err)
+		}
+		want := "ACME/alpha"
+		if includeForks {
+			want = "ACME/alpha, ACME/fork"
+		}
+		if names := repositoryNames(repos); names != want {
+			t.Errorf("GetRepositoriesFromOwner(include forks %t) = %s, want %s", includeForks, names, want)
+			continue
+		}
+		if includeForks && (!*repos[1].Fork || repos[1].ParentCloneURL == nil || *repos[1].ParentCloneURL != "https://bitbucket.example.com/scm/upstream/upstream.git") {
+			t.Errorf("GetRepositoriesFromOwner() did not link fork %s to the repository it was forked from", *repos[1].FullName)
+		}
+	}
+}
+
+func TestGetRepositoriesFromOwnerSkipsEmptyAndFailingRepositories(t *testing.T) {
+	path, f := defaultBranch("ACME", "alpha", "main")
+	client, _, done := newTestClient(t, false, map[string]fixture{
+		"/projects/ACME/repos?start=0&limit=1000": {body: fmt.Sprintf(`{"isLastPage": true, "values": [%s, %s, %s]}`,
+			repositoryJson("ACME", "empty", 9, ""), repositoryJson("ACME", "alpha", 10, ""), repositoryJson("ACME", "broken", 11, ""))},
+		path: f,
+		"/projects/ACME/repos/empty/branches/default":  {status: http.StatusNoContent},
+		"/projects/ACME/repos/broken/branches/default": {http.StatusInternalServerError, `{"errors": [{"message": "Repository is corrupt."}]}`},
+	})
+	defer done()
+	repos, err := client.GetRepositoriesFromOwner(common.Owner{Login: common.String("ACME"), Type: common.String(common.TargetTypeOrganization)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if names := repositoryNames(repos); names != "ACME/alpha" {
+		t.Errorf("GetRepositoriesFromOwner() = %s, want ACME/alpha", names)
+	}
+}
@@ -0,0 +1,3 @@
+package bitbucket
+
+const PullRequestRefSpec = "+refs/pull-requests/*/from:refs/pull-requests/*/from"
@@ -7,6 +7,9 @@ import (
 
 var UserAgent = fmt.Sprintf("%s v%s", Name, Version)
 
+// prefixes the keys of the projects personal Bitbucket Server repositories live in
+const BitbucketPersonalProjectPrefix = "~"
+
 func CleanUrlSpaces(dirtyStrings ...string) []string {
 	var result []string
 	for _, s := range dirtyStrings {
@@ -14,3 +17,12 @@ func CleanUrlSpaces(dirtyStrings ...string) []string {
 	}
 	return result
 }
+
+// the path of a Bitbucket Server repository in its web interface, where personal repositories belong to a
+// project keyed by the user's slug prefixed with a tilde
+func BitbucketRepositoryPath(projectKey string, slug string) string {
+	if strings.HasPrefix(projectKey, BitbucketPersonalProjectPrefix) {
+		return fmt.Sprintf("users/%s/repos/%s", strings.ToLower(strings.TrimPrefix(projectKey, BitbucketPersonalProjectPrefix)), slug)
+	}
+	return fmt.Sprintf("projects/%s/repos/%s", projectKey, slug)
+}
@@ -4,4 +4,5 @@ type IClient interface {
 	GetUserOrOrganization(login string) (*Owner, error)
 	GetRepositoriesFromOwner(target Owner) ([]*Repository, error)
 	GetOrganizationMembers(target Owner) ([]*Owner, error)
+	GetSubgroups(target Owner) ([]*Owner, error)
 }
@@ -8,3 +8,11 @@ func FileExists(path string) bool {
 	}
 	return true
 }
+
+func DirectoryExists(path string) bool {
+	info, err := os.Stat(path)
+	if err != nil {
+		return false
+	}
+	return info.IsDir()
+}
@@ -0,0 +1,251 @@
+package common
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	RateLimitMaxRetries          = 5
+	SecondaryRateLimitBackoff    = 60 * time.Second
+	SecondaryRateLimitMaxBackoff = 10 * time.Minute
+	rateLimitBodyLimit           = 64 * 1024
+)
+
+// the API quota last reported by a code host for any of its tokens, along with the number of requests retried
+// after hitting a limit; waits are computed from the quota of each token of the pool instead
+type RateLimit struct {
+	sync.Mutex
+
+	Limit     int
+	Remaining int
+	Reset     time.Time
+	Retries   int
+}
+
+// encodes the quota under the lock, since the transport goes on updating it while sessions are saved and
+// the web interface polls the stats
+func (r *RateLimit) MarshalJSON() ([]byte, error) {
+	r.Lock()
+	defer r.Unlock()
+	return json.Marshal(struct {
+		Limit     int
+		Remaining int
+		Reset     time.Time
+		Retries   int
+	}{r.Limit, r.Remaining, r.Reset, r.Retries})
+}
+
+// an HTTP transport shared by the API clients that authenticates requests with the tokens of a pool, paces
+// requests as the remaining quota runs low, rotating to another token when one has more left, and waits and
+// retries requests refused by primary or secondary (abuse) rate limits; it understands both the GitHub
+// X-RateLimit-* and the GitLab RateLimit-* headers
+type RateLimitTransport struct {
+	Base      http.RoundTripper
+	RateLimit *RateLimit
+	Logger    *Logger
+	Tokens    *TokenPool
+	SetToken  func(req *http.Request, token string)
+}
+
+func NewRateLimitTransport(rateLimit *RateLimit, logger *Logger, tokens *TokenPool, setToken func(req *http.Request, token string)) *RateLimitTransport {
+	return &RateLimitTransport{
+		Base:      http.DefaultTransport,
+		RateLimit: rateLimit,
+		Logger:    logger,
+		Tokens:    tokens,
+		SetToken:  setToken,
+	}
+}
+
+// returns a copy of the request authenticated with the current token of the pool, and the token's index
+func (t *RateLimitTransport) authorize(req *http.Request) (*http.Request, int, error) {
+	token, index, err := t.Tokens.Token()
+	if err != nil {
+		return nil, 0, err
+	}
+	authorized := req.WithContext(req.Context())
+	authorized.Header = make(http.Header, len(req.Header))
+	for name, values := range req.Header {
+		authorized.Header[name] = append([]string{}, values...)
+	}
+	t.SetToken(authorized, token)
+	return authorized, index, nil
+}
+
+func (t *RateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && req.Body != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			req = req.WithContext(req.Context())
+			req.Body = body
+		}
+		authorized, index, err := t.authorize(req)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := t.Base.RoundTrip(authorized)
+		if err != nil {
+			return nil, err
+		}
+		//the quota of the token that made the request, which other tokens of the pool don't share
+		remaining, reset, found := t.update(resp.Header)
+		if !found {
+			remaining, reset, _ = t.Tokens.Quota(index)
+		}
+		wait, limited := t.retryDelay(resp, reset, attempt)
+		rotated := false
+		if found || limited {
+			//a refused request leaves the token without quota, whatever its headers say
+			if limited {
+				remaining = 0
+			}
+			if rotated = t.Tokens.Update(index, remaining, reset); rotated {
+				t.Logger.Debug(" Access token #%d is running out of quota, rotating to another of %d tokens.\n", index+1, t.Tokens.Len())
+			}
+		}
+		//requests with a body that can't be replayed are not retried
+		if !limited || attempt >= RateLimitMaxRetries || (req.Body != nil && req.GetBody == nil) {
+			if found && !rotated {
+				t.throttle(req.Context(), remaining, reset)
+			}
+			return resp, nil
+		}
+		resp.Body.Close()
+		t.RateLimit.Lock()
+		t.RateLimit.Retries++
+		t.RateLimit.Unlock()
+		if rotated {
+			continue
+		}
+		t.Logger.Warn(" Rate limited by %s, retrying in %s...\n", req.URL.Host, wait.Round(time.Second))
+		if err := sleep(req.Context(), wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// records the quota reported by the response headers, if any, as the code host's last reported quota
+func (t *RateLimitTransport) update(header http.Header) (int, time.Time, bool) {
+	remainingHeader := firstHeader(header, "X-RateLimit-Remaining", "RateLimit-Remaining")
+	remaining, err := strconv.Atoi(remainingHeader)
+	if err != nil {
+		return 0, time.Time{}, false
+	}
+	limit, _ := strconv.Atoi(firstHeader(header, "X-RateLimit-Limit", "RateLimit-Limit"))
+	var reset time.Time
+	if seconds, err := strconv.ParseInt(firstHeader(header, "X-RateLimit-Reset", "RateLimit-Reset"), 10, 64); err == nil {
+		reset = time.Unix(seconds, 0)
+	} else if resetTime, err := time.Parse(time.RFC1123, header.Get("RateLimit-ResetTime")); err == nil {
+		reset = resetTime
+	}
+
+	t.RateLimit.Lock()
+	defer t.RateLimit.Unlock()
+	t.RateLimit.Limit = limit
+	t.RateLimit.Remaining = remaining
+	t.RateLimit.Reset = reset
+	return remaining, reset, true
+}
+
+// returns how long to wait before retrying a request refused by a rate limit, and whether it was refused,
+// where reset is when the quota of the token that made the request resets
+func (t *RateLimitTransport) retryDelay(resp *http.Response, reset time.Time, attempt int) (time.Duration, bool) {
+	if resp.StatusCode != http.StatusForbidden && resp.StatusCode != http.StatusTooManyRequests {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil {
+		return time.Duration(seconds) * time.Second, true
+	}
+	remaining := firstHeader(resp.Header, "X-RateLimit-Remaining", "RateLimit-Remaining")
+	if remaining == "0" {
+		wait := time.Until(reset) + time.Second
+		if wait < time.Second {
+			wait = time.Second
+		}
+		return wait, true
+	}
+	if resp.StatusCode == http.StatusTooManyRequests || isSecondaryRateLimit(resp) {
+		wait := SecondaryRateLimitBackoff << uint(attempt)
+		if wait > SecondaryRateLimitMaxBackoff {
+			wait = SecondaryRateLimitMaxBackoff
+		}
+		return wait, true
+	}
+	return 0, false
+}
+
+// secondary rate limits are refused with a 403 that only its message tells apart from a permission error;
+// the body is read and put back for the caller
+func isSecondaryRateLimit(resp *http.Response) bool {
+	original := resp.Body
+	body, err := ioutil.ReadAll(io.LimitReader(original, rateLimitBodyLimit))
+	resp.Body = struct {
+		io.Reader
+		io.Closer
+	}{io.MultiReader(bytes.NewReader(body), original), original}
+	if err != nil {
+		return false
+	}
+	message := strings.ToLower(string(body))
+	return strings.Contains(message, "secondary rate limit") || strings.Contains(message, "abuse")
+}
+
+// slows requests down as the remaining quota runs low, waiting for the quota to reset when nearly exhausted,
+// unless the request is canceled in the meantime
+func (t *RateLimitTransport) throttle(ctx context.Context, remaining int, reset time.Time) {
+	var wait time.Duration
+	switch {
+	case remaining >= 0 && remaining <= 25:
+		wait = time.Until(reset)
+	case remaining >= 26 && remaining <= 100:
+		wait = 5000 * time.Millisecond
+	case remaining >= 101 && remaining <= 200:
+		wait = 3000 * time.Millisecond
+	case remaining >= 201 && remaining <= 250:
+		wait = 2000 * time.Millisecond
+	case remaining >= 251 && remaining <= 350:
+		wait = 1000 * time.Millisecond
+	case remaining >= 351 && remaining <= 400:
+		wait = 500 * time.Millisecond
+	case remaining >= 401 && remaining <= 450:
+		wait = 250 * time.Millisecond
+	}
+	if wait <= 0 {
+		return
+	}
+	t.Logger.Debug(" Remaining requests before rate limit: %d.  Waiting %f seconds.\n", remaining, wait.Seconds())
+	sleep(ctx, wait)
+}
+
+// waits for the duration, returning early with the context's error when it is canceled first
+func sleep(ctx context.Context, wait time.Duration) error {
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func firstHeader(header http.Header, names ...string) string {
+	for _, name := range names {
+		if value := header.Get(name); value != "" {
+			return value
+		}
+	}
+	return ""
+}
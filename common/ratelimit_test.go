@@ -0,0 +1,260 @@
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+func newTestTransport(tokens ...TokenSource) *RateLimitTransport {
+	if len(tokens) == 0 {
+		tokens = []TokenSource{StaticToken("token")}
+	}
+	return NewRateLimitTransport(&RateLimit{}, &Logger{silent: true}, NewTokenPool(tokens...), func(req *http.Request, token string) {
+		req.Header.Set("Authorization", "token "+token)
+	})
+}
+
+func TestRoundTripStopsWaitingWhenTheRequestIsCanceled(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer server.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	req, _ := http.NewRequest("GET", server.URL, nil)
+	started := time.Now()
+	_, err := newTestTransport().RoundTrip(req.WithContext(ctx))
+	if err != context.DeadlineExceeded {
+		t.Errorf("RoundTrip() error = %v, want %v", err, context.DeadlineExceeded)
+	}
+	if elapsed := time.Since(started); elapsed > 5*time.Second {
+		t.Errorf("RoundTrip() waited %s after the request was canceled", elapsed)
+	}
+}
+
+func TestRoundTripTracksTheQuotaOfEachToken(t *testing.T) {
+	resets := map[string]time.Time{
+		"token a": time.Now().Add(time.Hour).Truncate(time.Second),
+		"token b": time.Now().Add(30 * time.Minute).Truncate(time.Second),
+	}
+	remaining := map[string]string{"token a": "50", "token b": "4000"}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		token := strings.TrimPrefix(r.Header.Get("Authorization"), "token ")
+		w.Header().Set("X-RateLimit-Limit", "5000")
+		w.Header().Set("X-RateLimit-Remaining", remaining[token])
+		w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(resets[token].Unix(), 10))
+	}))
+	defer server.Close()
+
+	transport := newTestTransport(StaticToken("token a"), StaticToken("token b"))
+	for i := 0; i < 2; i++ {
+		req, _ := http.NewRequest("GET", server.URL, nil)
+		resp, err := transport.RoundTrip(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+	}
+	//the first token ran low and requests rotated to the second one, without waiting for either to reset
+	for index, token := range []string{"token a", "token b"} {
+		left, reset, known := transport.Tokens.Quota(index)
+		want, _ := strconv.Atoi(remaining[token])
+		if !known || left != want || !reset.Equal(resets[token]) {
+			t.Errorf("Quota(%d) = %d, %s, %t, want %d, %s, true", index, left, reset, known, want, resets[token])
+		}
+	}
+	if _, index, _ := transport.Tokens.Token(); index != 1 {
+		t.Errorf("requests use token #%d, want #2", index+1)
+	}
+}
+
+func TestRetryDelayWaitsForTheTokensReset(t *testing.T) {
+	transport := newTestTransport()
+	//another token of the pool reported a later reset
+	transport.RateLimit.Reset = time.Now().Add(time.Hour)
+	resp := &http.Response{
+		StatusCode: http.StatusForbidden,
+		Header:     http.Header{"X-Ratelimit-Remaining": []string{"0"}},
+		Body:       nopCloser{strings.NewReader("API rate limit exceeded")},
+	}
+	wait, limited := transport.retryDelay(resp, time.Now().Add(10*time.Second), 0)
+	if !limited || wait > 11*time.Second || wait < 9*time.Second {
+		t.Errorf("retryDelay() = %s, %t, want about 11s, true", wait, limited)
+	}
+}
+
+func TestSecondaryRateLimitBackoffIsCapped(t *testing.T) {
+	transport := newTestTransport()
+	for attempt := 0; attempt <= RateLimitMaxRetries; attempt++ {
+		resp := &http.Response{
+			StatusCode: http.StatusForbidden,
+			Header:     http.Header{},
+			Body:       nopCloser{strings.NewReader("You have exceeded a secondary rate limit.")},
+		}
+		wait, limited := transport.retryDelay(resp, time.Time{}, attempt)
+		if !limited {
+			t.Fatalf("retryDelay(attempt %d) did not recognize the secondary rate limit", attempt)
+		}
+		if wait > SecondaryRateLimitMaxBackoff {
+			t.Errorf("retryDelay(attempt %d) = %s, want at most %s", attempt, wait, SecondaryRateLimitMaxBackoff)
+		}
+	}
+}
+
+func TestRateLimitIsEncodedWhileUpdated(t *testing.T) {
+	transport := newTestTransport()
+	header := http.Header{}
+	header.Set("X-RateLimit-Limit", "5000")
+	header.Set("X-RateLimit-Remaining", "4999")
+	header.Set("X-RateLimit-Reset", "1700000000")
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for i := 0; i < 1000; i++ {
+			transport.update(header)
+		}
+	}()
+	for i := 0; i < 1000; i++ {
+		if _, err := json.Marshal(map[string]*RateLimit{"github": transport.RateLimit}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	wg.Wait()
+	data, _ := json.Marshal(transport.RateLimit)
+	var decoded RateLimit
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Limit != 5000 || decoded.Remaining != 4999 || decoded.Reset.Unix() != 1700000000 {
+		t.Errorf("decoded %s as limit %d, remaining %d, reset %s", data, decoded.Limit, decoded.Remaining, decoded.Reset)
+	}
+}
+
+type nopCloser struct {
+	*strings.Reader
+}
+
+func (nopCloser) Close() error {
+	return nil
+}
+
+// a code host stand-in answering each request with the next of its responses, and with success once they run
+// out, recording the token of every request
+type fakeRateLimitedApi struct {
+	sync.Mutex
+
+	responses []func(w http.ResponseWriter)
+	tokens    []string
+}
+
+func (f *fakeRateLimitedApi) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.Lock()
+	defer f.Unlock()
+	f.tokens = append(f.tokens, strings.TrimPrefix(r.Header.Get("Authorization"), "token "))
+	w.Header().Set("X-RateLimit-Limit", "5000")
+	if len(f.responses) == 0 {
+		w.Header().Set("X-RateLimit-Remaining", "4999")
+		w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10))
+		return
+	}
+	respond := f.responses[0]
+	f.responses = f.responses[1:]
+	respond(w)
+}
+
+// sends a request through an HTTP client using the transport, returning the status code and how long it took
+func getThroughTransport(ctx context.Context, transport *RateLimitTransport, url string) (int, time.Duration, error) {
+	req, _ := http.NewRequest("GET", url, nil)
+	started := time.Now()
+	resp, err := (&http.Client{Transport: transport}).Do(req.WithContext(ctx))
+	if err != nil {
+		return 0, time.Since(started), err
+	}
+	resp.Body.Close()
+	return resp.StatusCode, time.Since(started), nil
+}
+
+func TestClientWaitsForRetryAfterAndRetries(t *testing.T) {
+	for _, status := range []int{http.StatusTooManyRequests, http.StatusForbidden} {
+		api := &fakeRateLimitedApi{responses: []func(w http.ResponseWriter){func(w http.ResponseWriter) {
+			w.Header().Set("Retry-After", "1")
+			w.WriteHeader(status)
+			w.Write([]byte(`{"message": "You have exceeded a secondary rate limit."}`))
+		}}}
+		server := httptest.NewServer(api)
+		transport := newTestTransport()
+		code, elapsed, err := getThroughTransport(context.Background(), transport, server.URL)
+		server.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if code != http.StatusOK || len(api.tokens) != 2 {
+			t.Errorf("%d: got %d after %d requests, want 200 after 2", status, code, len(api.tokens))
+		}
+		if elapsed < time.Second {
+			t.Errorf("%d: retried after %s, want at least the second of Retry-After", status, elapsed)
+		}
+		if transport.RateLimit.Retries != 1 {
+			t.Errorf("%d: counted %d retries, want 1", status, transport.RateLimit.Retries)
+		}
+	}
+}
+
+func TestClientBacksOffFromSecondaryRateLimits(t *testing.T) {
+	api := &fakeRateLimitedApi{responses: []func(w http.ResponseWriter){func(w http.ResponseWriter) {
+		w.Header().Set("X-RateLimit-Remaining", "4000")
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"message": "You have exceeded a secondary rate limit. Please wait a few minutes before you try again."}`))
+	}}}
+	server := httptest.NewServer(api)
+	defer server.Close()
+
+	//the backoff outlasts the request, which is given up while waiting rather than sent again at once
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+	transport := newTestTransport()
+	if _, _, err := getThroughTransport(ctx, transport, server.URL); err == nil {
+		t.Fatal("request refused by a secondary rate limit succeeded without backing off")
+	}
+	if len(api.tokens) != 1 || transport.RateLimit.Retries != 1 {
+		t.Errorf("sent %d requests and counted %d retries, want 1 and 1", len(api.tokens), transport.RateLimit.Retries)
+	}
+}
+
+func TestClientRotatesTokensOutOfQuota(t *testing.T) {
+	exhausted := func(w http.ResponseWriter) {
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.Header().Set("X-RateLimit-Reset", strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10))
+		w.WriteHeader(http.StatusForbidden)
+		w.Write([]byte(`{"message": "API rate limit exceeded"}`))
+	}
+	api := &fakeRateLimitedApi{responses: []func(w http.ResponseWriter){exhausted}}
+	server := httptest.NewServer(api)
+	defer server.Close()
+
+	transport := newTestTransport(StaticToken("token a"), StaticToken("token b"))
+	for i := 0; i < 2; i++ {
+		code, elapsed, err := getThroughTransport(context.Background(), transport, server.URL)
+		if err != nil {
+			t.Fatal(err)
+		}
+		//the other token has quota left, so the hour until the first one resets is not waited for
+		if code != http.StatusOK || elapsed > 5*time.Second {
+			t.Errorf("request #%d got %d after %s, want 200 without waiting", i+1, code, elapsed)
+		}
+	}
+	want := "token a, token b, token b"
+	if tokens := strings.Join(api.tokens, ", "); tokens != want {
+		t.Errorf("requests used %s, want %s", tokens, want)
+	}
+}
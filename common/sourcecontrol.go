@@ -1,56 +1,136 @@
 package common
 
 import (
+	"fmt"
+	"io/ioutil"
+	"sort"
+	"strings"
+	"time"
+
 	"gopkg.in/src-d/go-git.v4"
+	"gopkg.in/src-d/go-git.v4/config"
 	"gopkg.in/src-d/go-git.v4/plumbing"
+	"gopkg.in/src-d/go-git.v4/plumbing/format/diff"
 	"gopkg.in/src-d/go-git.v4/plumbing/object"
+	"gopkg.in/src-d/go-git.v4/plumbing/transport"
+	"gopkg.in/src-d/go-git.v4/storage/memory"
 	"gopkg.in/src-d/go-git.v4/utils/merkletrie"
 )
 
 const (
 	TargetTypeUser         = "User"
 	TargetTypeOrganization = "Organization"
+	TargetTypeDirectory    = "Directory"
+)
+
+const (
+	VisibilityPublic   = "public"
+	VisibilityPrivate  = "private"
+	VisibilityInternal = "internal"
+)
+
+const (
+	SourceTypeGithub    = "Github"
+	SourceTypeGitLab    = "GitLab"
+	SourceTypeBitbucket = "Bitbucket"
+	SourceTypeLocal     = "Local"
 )
 
 type CloneConfiguration struct {
 	InMemClone *bool
+	AllRefs    *bool
 	Url        *string
-	Username   *string
-	Token      *string
+	Auth       transport.AuthMethod
 	Branch     *string
 	Depth      *int
 }
 
+// clones the branch of a remote repository, or all of its refs, to a temporary directory or memory. the returned
+// path is the directory, which the caller removes once done
+func CloneRepository(cloneConfig *CloneConfiguration) (*git.Repository, string, error) {
+	cloneOptions := &git.CloneOptions{
+		URL:           *cloneConfig.Url,
+		Depth:         *cloneConfig.Depth,
+		ReferenceName: plumbing.ReferenceName(fmt.Sprintf("refs/heads/%s", *cloneConfig.Branch)),
+		SingleBranch:  true,
+		Tags:          git.NoTags,
+		Auth:          cloneConfig.Auth,
+	}
+	if *cloneConfig.AllRefs {
+		cloneOptions.SingleBranch = false
+		cloneOptions.Tags = git.AllTags
+	}
+
+	var repository *git.Repository
+	var err error
+	var dir string
+	if !*cloneConfig.InMemClone {
+		dir, err = ioutil.TempDir("", "gitrob")
+		if err != nil {
+			return nil, "", err
+		}
+		repository, err = git.PlainClone(dir, false, cloneOptions)
+	} else {
+		repository, err = git.Clone(memory.NewStorage(), nil, cloneOptions)
+	}
+	if err != nil {
+		return nil, dir, err
+	}
+	return repository, dir, nil
+}
+
+type ChangeLine struct {
+	Operation     diff.Operation
+	Content       string
+	OldLineNumber int
+	NewLineNumber int
+}
+
 type Owner struct {
-	Login     *string
-	ID        *int64
-	Type      *string
-	Name      *string
-	AvatarURL *string
-	URL       *string
-	Company   *string
-	Blog      *string
-	Location  *string
-	Email     *string
-	Bio       *string
+	Login      *string
+	ID         *int64
+	Type       *string
+	Name       *string
+	AvatarURL  *string
+	URL        *string
+	Company    *string
+	Blog       *string
+	Location   *string
+	Email      *string
+	Bio        *string
+	SourceType *string
 }
 
 type Repository struct {
-	Owner         *string
-	ID            *int64
-	Name          *string
-	FullName      *string
-	CloneURL      *string
-	URL           *string
-	DefaultBranch *string
-	Description   *string
-	Homepage      *string
+	Owner          *string
+	ID             *int64
+	Name           *string
+	FullName       *string
+	CloneURL       *string
+	SSHCloneURL    *string
+	URL            *string
+	DefaultBranch  *string
+	Description    *string
+	Homepage       *string
+	Fork           *bool
+	ParentCloneURL *string
+	Visibility     *string
+	Archived       *bool
+	PushedAt       *time.Time
+	Size           *int64 //kilobytes
+	SourceType     *string
 }
 
 const (
 	EmptyTreeCommitId = "4b825dc642cb6eb9a060e54bf8d69288fbee4904"
+	BranchRefPrefix   = "refs/heads/"
+	RemoteRefPrefix   = "refs/remotes/origin/"
+	UpstreamRemote    = "upstream"
+	UpstreamRefPrefix = "refs/remotes/upstream/"
 )
 
+var PullRequestRefPrefixes = []string{"refs/pull/", "refs/merge-requests/", "refs/pull-requests/"}
+
 func getParentCommit(commit *object.Commit, repo *git.Repository) (*object.Commit, error) {
 	if commit.NumParents() == 0 {
 		parentCommit, err := repo.CommitObject(plumbing.NewHash(EmptyTreeCommitId))
@@ -83,6 +163,247 @@ func GetRepositoryHistory(repository *git.Repository) ([]*object.Commit, error)
 	return commits, nil
 }
 
+// walks the union of the histories of every reference in the repository, visiting each commit
+// once and recording the references each commit is reachable from; pull requests are only recorded
+// for the commits no branch or tag reaches
+func GetRepositoryHistoryFromRefs(repository *git.Repository) ([]*object.Commit, map[plumbing.Hash][]string, error) {
+	tips, err := getReferenceTips(repository)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var tipNames []string
+	for name := range tips {
+		tipNames = append(tipNames, name)
+	}
+	sort.Strings(tipNames)
+
+	commits := make(map[plumbing.Hash]*object.Commit)
+	var queue []*object.Commit
+	for _, name := range tipNames {
+		commit, err := repository.CommitObject(tips[name])
+		if err != nil {
+			continue
+		}
+		if _, ok := commits[commit.Hash]; !ok {
+			commits[commit.Hash] = commit
+			queue = append(queue, commit)
+		}
+	}
+	for i := 0; i < len(queue); i++ {
+		for _, parentHash := range queue[i].ParentHashes {
+			if _, ok := commits[parentHash]; ok {
+				continue
+			}
+			//parents beyond the depth of a shallow clone are not available
+			parent, err := repository.CommitObject(parentHash)
+			if err != nil {
+				continue
+			}
+			commits[parentHash] = parent
+			queue = append(queue, parent)
+		}
+	}
+
+	reachableFrom := make(map[plumbing.Hash]map[string]bool)
+	pendingChildren := make(map[plumbing.Hash]int)
+	for _, commit := range queue {
+		reachableFrom[commit.Hash] = make(map[string]bool)
+		for _, parentHash := range commit.ParentHashes {
+			if _, ok := commits[parentHash]; ok {
+				pendingChildren[parentHash]++
+			}
+		}
+	}
+	for name, hash := range tips {
+		if _, ok := commits[hash]; ok {
+			reachableFrom[hash][name] = true
+		}
+	}
+
+	//propagate reference names from children to parents so that every commit is visited once
+	var history []*object.Commit
+	var ready []*object.Commit
+	for _, commit := range queue {
+		if pendingChildren[commit.Hash] == 0 {
+			ready = append(ready, commit)
+		}
+	}
+	for len(ready) > 0 {
+		commit := ready[0]
+		ready = ready[1:]
+		history = append(history, commit)
+		//pull requests are only told apart for the commits they add, as every ancestor of a commit on a
+		//branch or tag is on it too
+		if names := reachableFrom[commit.Hash]; hasBranchOrTag(names) {
+			for name := range names {
+				if IsPullRequestRef(name) {
+					delete(names, name)
+				}
+			}
+		}
+		for _, parentHash := range commit.ParentHashes {
+			if _, ok := commits[parentHash]; !ok {
+				continue
+			}
+			for name := range reachableFrom[commit.Hash] {
+				reachableFrom[parentHash][name] = true
+			}
+			pendingChildren[parentHash]--
+			if pendingChildren[parentHash] == 0 {
+				ready = append(ready, commits[parentHash])
+			}
+		}
+	}
+
+	refs := make(map[plumbing.Hash][]string)
+	for hash, names := range reachableFrom {
+		refs[hash] = sortedNames(names)
+	}
+	return history, refs, nil
+}
+
+// maps branch, tag and other reference names to the commits they point at; remote tracking
+// branches are reported under their branch name unless a local branch of that name points elsewhere,
+// such as when it has commits that were not pushed, in which case both are kept
+func getReferenceTips(repository *git.Repository) (map[string]plumbing.Hash, error) {
+	tips := make(map[string]plumbing.Hash)
+	iter, err := repository.References()
+	if err != nil {
+		return nil, err
+	}
+	err = iter.ForEach(func(ref *plumbing.Reference) error {
+		if ref.Type() != plumbing.HashReference {
+			return nil
+		}
+		name := ref.Name().String()
+		if strings.HasPrefix(name, UpstreamRefPrefix) {
+			//the branches of a fork's upstream are only fetched to leave out the history they share
+			return nil
+		}
+		hash := ref.Hash()
+		if ref.Name().IsTag() {
+			//annotated tags point at a tag object rather than the commit itself
+			if tag, err := repository.TagObject(hash); err == nil {
+				commit, err := tag.Commit()
+				if err != nil {
+					return nil
+				}
+				hash = commit.Hash
+			}
+		}
+		tips[name] = hash
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for name, hash := range tips {
+		if !strings.HasPrefix(name, RemoteRefPrefix) {
+			continue
+		}
+		branch := BranchRefPrefix + strings.TrimPrefix(name, RemoteRefPrefix)
+		if branchHash, ok := tips[branch]; ok && branchHash != hash {
+			continue
+		}
+		delete(tips, name)
+		tips[branch] = hash
+	}
+	return tips, nil
+}
+
+// tells pull and merge request refs of GitHub, GitLab and Bitbucket Server apart from branches and tags
+func IsPullRequestRef(name string) bool {
+	for _, prefix := range PullRequestRefPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func hasBranchOrTag(names map[string]bool) bool {
+	for name := range names {
+		if !IsPullRequestRef(name) {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedNames(names map[string]bool) []string {
+	var sorted []string
+	for name := range names {
+		sorted = append(sorted, name)
+	}
+	sort.Strings(sorted)
+	return sorted
+}
+
+// fetches additional references, such as pull request heads, into an existing clone
+func FetchRefs(repository *git.Repository, refSpec string, depth int, auth transport.AuthMethod) error {
+	err := repository.Fetch(&git.FetchOptions{
+		RefSpecs: []config.RefSpec{config.RefSpec(refSpec)},
+		Depth:    depth,
+		Auth:     auth,
+		Tags:     git.NoTags,
+	})
+	if err == git.NoErrAlreadyUpToDate {
+		return nil
+	}
+	return err
+}
+
+// fetches the branches of the repository a fork was created from and returns the commits reachable from them,
+// i.e. the history the fork shares with its upstream
+func GetUpstreamCommits(repository *git.Repository, url string, depth int, auth transport.AuthMethod) (map[plumbing.Hash]bool, error) {
+	remote, err := repository.CreateRemote(&config.RemoteConfig{Name: UpstreamRemote, URLs: []string{url}})
+	if err != nil {
+		return nil, err
+	}
+	err = remote.Fetch(&git.FetchOptions{
+		RemoteName: UpstreamRemote,
+		RefSpecs:   []config.RefSpec{config.RefSpec("+" + BranchRefPrefix + "*:" + UpstreamRefPrefix + "*")},
+		Depth:      depth,
+		Auth:       auth,
+		Tags:       git.NoTags,
+	})
+	if err != nil && err != git.NoErrAlreadyUpToDate {
+		return nil, err
+	}
+
+	iter, err := repository.References()
+	if err != nil {
+		return nil, err
+	}
+	commits := make(map[plumbing.Hash]bool)
+	var queue []plumbing.Hash
+	err = iter.ForEach(func(ref *plumbing.Reference) error {
+		if ref.Type() == plumbing.HashReference && strings.HasPrefix(ref.Name().String(), UpstreamRefPrefix) && !commits[ref.Hash()] {
+			commits[ref.Hash()] = true
+			queue = append(queue, ref.Hash())
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	for i := 0; i < len(queue); i++ {
+		//parents beyond the depth of a shallow fetch are not available
+		commit, err := repository.CommitObject(queue[i])
+		if err != nil {
+			continue
+		}
+		for _, parentHash := range commit.ParentHashes {
+			if !commits[parentHash] {
+				commits[parentHash] = true
+				queue = append(queue, parentHash)
+			}
+		}
+	}
+	return commits, nil
+}
+
 func GetChanges(commit *object.Commit, repo *git.Repository) (object.Changes, error) {
 	parentCommit, err := getParentCommit(commit, repo)
 	if err != nil {
@@ -136,19 +457,50 @@ func GetChangePath(change *object.Change) string {
 	}
 }
 
-func GetChangeContent(change *object.Change) (string, error) {
-	patch, err := change.Patch()
-	if err != nil {
-		return "", err
+// reports whether the patch of a change is of a binary file, as sniffed by go-git while reading the
+// file for the patch
+func IsBinaryPatch(patch *object.Patch) bool {
+	for _, filePatch := range patch.FilePatches() {
+		if filePatch.IsBinary() {
+			return true
+		}
 	}
-	result := ""
+	return false
+}
+
+// splits a patch into lines numbered against the old and new versions of the file, leaving out binary files
+func GetPatchLines(patch *object.Patch) []ChangeLine {
+	var lines []ChangeLine
 	for _, filePatch := range patch.FilePatches() {
 		if filePatch.IsBinary() {
 			continue
 		}
+		oldLineNumber, newLineNumber := 1, 1
 		for _, chunk := range filePatch.Chunks() {
-			result += chunk.Content()
+			for _, content := range strings.SplitAfter(chunk.Content(), "\n") {
+				if content == "" {
+					continue
+				}
+				line := ChangeLine{
+					Operation: chunk.Type(),
+					Content:   strings.TrimSuffix(content, "\n"),
+				}
+				switch chunk.Type() {
+				case diff.Equal:
+					line.OldLineNumber = oldLineNumber
+					line.NewLineNumber = newLineNumber
+					oldLineNumber++
+					newLineNumber++
+				case diff.Add:
+					line.NewLineNumber = newLineNumber
+					newLineNumber++
+				case diff.Delete:
+					line.OldLineNumber = oldLineNumber
+					oldLineNumber++
+				}
+				lines = append(lines, line)
+			}
 		}
 	}
-	return result, nil
+	return lines
 }
@@ -0,0 +1,353 @@
+package common
+
+import (
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"gopkg.in/src-d/go-git.v4"
+	"gopkg.in/src-d/go-git.v4/plumbing"
+	"gopkg.in/src-d/go-git.v4/plumbing/filemode"
+	"gopkg.in/src-d/go-git.v4/plumbing/format/diff"
+	"gopkg.in/src-d/go-git.v4/plumbing/object"
+	"gopkg.in/src-d/go-git.v4/storage/memory"
+)
+
+// builds the history of an in-memory repository commit by commit
+type testHistory struct {
+	t          *testing.T
+	repository *git.Repository
+	names      map[plumbing.Hash]string
+}
+
+func newTestHistory(t *testing.T) *testHistory {
+	repository, err := git.Init(memory.NewStorage(), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testHistory{t: t, repository: repository, names: map[plumbing.Hash]string{}}
+}
+
+// the history of a bare repository on disk, which clones can be made of
+func newTestRemoteHistory(t *testing.T, dir string) *testHistory {
+	repository, err := git.PlainInit(dir, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &testHistory{t: t, repository: repository, names: map[plumbing.Hash]string{}}
+}
+
+func (h *testHistory) store(o interface {
+	Encode(plumbing.EncodedObject) error
+}) plumbing.Hash {
+	encoded := h.repository.Storer.NewEncodedObject()
+	if err := o.Encode(encoded); err != nil {
+		h.t.Fatal(err)
+	}
+	hash, err := h.repository.Storer.SetEncodedObject(encoded)
+	if err != nil {
+		h.t.Fatal(err)
+	}
+	return hash
+}
+
+// commits an empty tree under the given name, which the commit is reported by
+func (h *testHistory) commit(name string, parents ...plumbing.Hash) plumbing.Hash {
+	return h.commitTree(name, h.store(&object.Tree{}), parents...)
+}
+
+// commits a tree of a single file under the given name
+func (h *testHistory) commitFile(name string, path string, content string, parents ...plumbing.Hash) plumbing.Hash {
+	blob := h.repository.Storer.NewEncodedObject()
+	blob.SetType(plumbing.BlobObject)
+	writer, err := blob.Writer()
+	if err != nil {
+		h.t.Fatal(err)
+	}
+	if _, err := writer.Write([]byte(content)); err != nil {
+		h.t.Fatal(err)
+	}
+	writer.Close()
+	blobHash, err := h.repository.Storer.SetEncodedObject(blob)
+	if err != nil {
+		h.t.Fatal(err)
+	}
+	tree := &object.Tree{Entries: []object.TreeEntry{{Name: path, Mode: filemode.Regular, Hash: blobHash}}}
+	return h.commitTree(name, h.store(tree), parents...)
+}
+
+func (h *testHistory) commitTree(name string, tree plumbing.Hash, parents ...plumbing.Hash) plumbing.Hash {
+	signature := object.Signature{Name: "Test", Email: "test@example.com", When: time.Unix(1600000000, 0)}
+	hash := h.store(&object.Commit{
+		Author:       signature,
+		Committer:    signature,
+		Message:      name,
+		TreeHash:     tree,
+		ParentHashes: parents,
+	})
+	h.names[hash] = name
+	return hash
+}
+
+// the patch of the single change made by a commit
+func (h *testHistory) patch(hash plumbing.Hash) *object.Patch {
+	commit, err := h.repository.CommitObject(hash)
+	if err != nil {
+		h.t.Fatal(err)
+	}
+	changes, err := GetChanges(commit, h.repository)
+	if err != nil {
+		h.t.Fatal(err)
+	}
+	if len(changes) != 1 {
+		h.t.Fatalf("%s makes %d changes, want 1", h.names[hash], len(changes))
+	}
+	patch, err := changes[0].Patch()
+	if err != nil {
+		h.t.Fatal(err)
+	}
+	return patch
+}
+
+func (h *testHistory) ref(name string, hash plumbing.Hash) {
+	if err := h.repository.Storer.SetReference(plumbing.NewHashReference(plumbing.ReferenceName(name), hash)); err != nil {
+		h.t.Fatal(err)
+	}
+}
+
+func (h *testHistory) annotatedTag(name string, hash plumbing.Hash) {
+	signature := object.Signature{Name: "Test", Email: "test@example.com", When: time.Unix(1600000000, 0)}
+	h.ref("refs/tags/"+name, h.store(&object.Tag{
+		Name:       name,
+		Tagger:     signature,
+		Message:    name,
+		TargetType: plumbing.CommitObject,
+		Target:     hash,
+	}))
+}
+
+// walks the history from every ref, returning the names of the commits in the order they were visited and
+// the refs of each commit by name
+func (h *testHistory) walk() ([]string, map[string]string) {
+	history, refs, err := GetRepositoryHistoryFromRefs(h.repository)
+	if err != nil {
+		h.t.Fatal(err)
+	}
+	var visited []string
+	for _, commit := range history {
+		visited = append(visited, h.names[commit.Hash])
+	}
+	refsByName := make(map[string]string)
+	for hash, names := range refs {
+		refsByName[h.names[hash]] = strings.Join(names, ", ")
+	}
+	return visited, refsByName
+}
+
+func indexOf(names []string, name string) int {
+	for i, n := range names {
+		if n == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func TestHistoryFromRefsVisitsCommitsSharedByBranchesOnce(t *testing.T) {
+	h := newTestHistory(t)
+	root := h.commit("root")
+	shared := h.commit("shared", root)
+	master := h.commit("master", shared)
+	feature := h.commit("feature", shared)
+	merge := h.commit("merge", master, feature)
+	h.ref("refs/heads/master", merge)
+	h.ref("refs/remotes/origin/feature", feature)
+	h.ref("refs/remotes/origin/master", merge)
+
+	visited, refs := h.walk()
+	if len(visited) != 5 {
+		t.Fatalf("visited %q, want each of the 5 commits once", visited)
+	}
+	for _, name := range []string{"root", "shared", "master", "feature", "merge"} {
+		if indexOf(visited, name) == -1 {
+			t.Errorf("%s was not visited", name)
+		}
+	}
+	//children are visited before their parents
+	for child, parent := range map[string]string{"merge": "master", "feature": "shared", "master": "shared", "shared": "root"} {
+		if indexOf(visited, child) > indexOf(visited, parent) {
+			t.Errorf("visited %s after its parent %s: %q", child, parent, visited)
+		}
+	}
+	//remote tracking branches are reported as the branches they track
+	tests := map[string]string{
+		"root":    "refs/heads/feature, refs/heads/master",
+		"shared":  "refs/heads/feature, refs/heads/master",
+		"feature": "refs/heads/feature, refs/heads/master",
+		"master":  "refs/heads/master",
+		"merge":   "refs/heads/master",
+	}
+	for name, want := range tests {
+		if refs[name] != want {
+			t.Errorf("%s is reachable from %q, want %q", name, refs[name], want)
+		}
+	}
+}
+
+func TestHistoryFromRefsVisitsCommitsOnlyTagsReach(t *testing.T) {
+	h := newTestHistory(t)
+	root := h.commit("root")
+	h.ref("refs/heads/master", h.commit("master", root))
+	released := h.commit("released", root)
+	h.annotatedTag("v1.0", released)
+	h.ref("refs/tags/v0.9", h.commit("hotfix", root))
+
+	visited, refs := h.walk()
+	if len(visited) != 4 {
+		t.Fatalf("visited %q, want each of the 4 commits once", visited)
+	}
+	tests := map[string]string{
+		"root":     "refs/heads/master, refs/tags/v0.9, refs/tags/v1.0",
+		"master":   "refs/heads/master",
+		"released": "refs/tags/v1.0",
+		"hotfix":   "refs/tags/v0.9",
+	}
+	for name, want := range tests {
+		if refs[name] != want {
+			t.Errorf("%s is reachable from %q, want %q", name, refs[name], want)
+		}
+	}
+}
+
+func TestHistoryFromRefsAttributesPullRequestCommitsOnBranchesToTheBranches(t *testing.T) {
+	h := newTestHistory(t)
+	root := h.commit("root")
+	merged := h.commit("merged", root)
+	h.ref("refs/heads/master", h.commit("master", merged))
+	//a merged pull request, whose head is on master, and an open one
+	h.ref("refs/pull/1/head", merged)
+	open := h.commit("open", merged)
+	h.ref("refs/pull/2/head", h.commit("open again", open))
+
+	_, refs := h.walk()
+	tests := map[string]string{
+		"root":       "refs/heads/master",
+		"merged":     "refs/heads/master",
+		"master":     "refs/heads/master",
+		"open":       "refs/pull/2/head",
+		"open again": "refs/pull/2/head",
+	}
+	for name, want := range tests {
+		if refs[name] != want {
+			t.Errorf("%s is reachable from %q, want %q", name, refs[name], want)
+		}
+	}
+}
+
+func TestFetchRefsFetchesPullRequestsIntoClones(t *testing.T) {
+	//local clones are served by the git binary
+	if _, err := exec.LookPath("git"); err != nil {
+		t.Skip("git is not installed")
+	}
+	dir, err := ioutil.TempDir("", "gitrob")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	remote := newTestRemoteHistory(t, filepath.Join(dir, "remote.git"))
+	root := remote.commit("root")
+	merged := remote.commit("merged", root)
+	remote.ref("refs/heads/master", remote.commit("master", merged))
+	remote.ref("refs/pull/1/head", merged)
+	remote.ref("refs/pull/2/head", remote.commit("open", merged))
+
+	clone, err := git.PlainClone(filepath.Join(dir, "clone"), true, &git.CloneOptions{URL: filepath.Join(dir, "remote.git"), Tags: git.NoTags})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := FetchRefs(clone, "+refs/pull/*/head:refs/pull/*/head", 0, nil); err != nil {
+		t.Fatal(err)
+	}
+	//fetching again finds nothing new, which is not an error
+	if err := FetchRefs(clone, "+refs/pull/*/head:refs/pull/*/head", 0, nil); err != nil {
+		t.Errorf("FetchRefs() again = %v, want nil", err)
+	}
+	cloned := &testHistory{t: t, repository: clone, names: remote.names}
+	_, refs := cloned.walk()
+	tests := map[string]string{
+		"merged": "refs/heads/master",
+		"open":   "refs/pull/2/head",
+	}
+	for name, want := range tests {
+		if refs[name] != want {
+			t.Errorf("%s is reachable from %q in the clone, want %q", name, refs[name], want)
+		}
+	}
+}
+
+func TestGetPatchLinesNumbersLinesOfEachVersion(t *testing.T) {
+	h := newTestHistory(t)
+	root := h.commit("root")
+	before := h.commitFile("before", "config.yml", "a\nb\nc\nd\n", root)
+	after := h.commitFile("after", "config.yml", "a\nB\nc\nd\ne\n", before)
+
+	tests := []ChangeLine{
+		{Operation: diff.Equal, Content: "a", OldLineNumber: 1, NewLineNumber: 1},
+		{Operation: diff.Delete, Content: "b", OldLineNumber: 2},
+		{Operation: diff.Add, Content: "B", NewLineNumber: 2},
+		{Operation: diff.Equal, Content: "c", OldLineNumber: 3, NewLineNumber: 3},
+		{Operation: diff.Equal, Content: "d", OldLineNumber: 4, NewLineNumber: 4},
+		{Operation: diff.Add, Content: "e", NewLineNumber: 5},
+	}
+	patch := h.patch(after)
+	if IsBinaryPatch(patch) {
+		t.Errorf("IsBinaryPatch() = true for a text file, want false")
+	}
+	lines := GetPatchLines(patch)
+	if len(lines) != len(tests) {
+		t.Fatalf("GetPatchLines() = %v, want %v", lines, tests)
+	}
+	for i, want := range tests {
+		if lines[i] != want {
+			t.Errorf("GetPatchLines()[%d] = %+v, want %+v", i, lines[i], want)
+		}
+	}
+}
+
+func TestGetPatchLinesOfAddedFile(t *testing.T) {
+	h := newTestHistory(t)
+	root := h.commit("root")
+	added := h.commitFile("added", "config.yml", "a\nb", root)
+
+	tests := []ChangeLine{
+		{Operation: diff.Add, Content: "a", NewLineNumber: 1},
+		{Operation: diff.Add, Content: "b", NewLineNumber: 2},
+	}
+	lines := GetPatchLines(h.patch(added))
+	if len(lines) != len(tests) {
+		t.Fatalf("GetPatchLines() = %v, want %v", lines, tests)
+	}
+	for i, want := range tests {
+		if lines[i] != want {
+			t.Errorf("GetPatchLines()[%d] = %+v, want %+v", i, lines[i], want)
+		}
+	}
+}
+
+func TestGetPatchLinesLeavesOutBinaryFiles(t *testing.T) {
+	h := newTestHistory(t)
+	root := h.commit("root")
+	added := h.commitFile("added", "logo.png", "\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR", root)
+
+	patch := h.patch(added)
+	if !IsBinaryPatch(patch) {
+		t.Errorf("IsBinaryPatch() = false for a binary file, want true")
+	}
+	if lines := GetPatchLines(patch); len(lines) != 0 {
+		t.Errorf("GetPatchLines() = %v for a binary file, want no lines", lines)
+	}
+}
@@ -0,0 +1,40 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+
+	"gopkg.in/src-d/go-git.v4/plumbing/transport"
+	"gopkg.in/src-d/go-git.v4/plumbing/transport/ssh"
+)
+
+// the user GitHub and GitLab accept SSH connections for git as
+const SSHUser = "git"
+
+// returns the authentication for cloning over SSH with a private key file, or with the keys of the SSH agent
+// when no file is given; host keys are checked against the known_hosts file, or the default known_hosts files
+// of ssh when none is given
+func NewSSHAuth(keyFile string, passphrase string, knownHostsFile string) (transport.AuthMethod, error) {
+	var files []string
+	if knownHostsFile != "" {
+		files = append(files, knownHostsFile)
+	}
+	hostKeyCallback, err := ssh.NewKnownHostsCallback(files...)
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("Unable to load known hosts: %s", err))
+	}
+	if keyFile != "" {
+		auth, err := ssh.NewPublicKeysFromFile(SSHUser, keyFile, passphrase)
+		if err != nil {
+			return nil, errors.New(fmt.Sprintf("Unable to load SSH key %s: %s", keyFile, err))
+		}
+		auth.HostKeyCallback = hostKeyCallback
+		return auth, nil
+	}
+	auth, err := ssh.NewSSHAgentAuth(SSHUser)
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("Unable to connect to the SSH agent: %s", err))
+	}
+	auth.HostKeyCallback = hostKeyCallback
+	return auth, nil
+}
@@ -23,3 +23,28 @@ func TruncateString(str string, maxLength int) string {
 	}
 	return str
 }
+
+// masks all but the first prefixLength and last suffixLength characters of a secret
+func RedactString(str string, prefixLength int, suffixLength int) string {
+	runes := []rune(str)
+	if prefixLength < 0 {
+		prefixLength = 0
+	}
+	if suffixLength < 0 {
+		suffixLength = 0
+	}
+	if len(runes) <= prefixLength+suffixLength {
+		return strings.Repeat("*", len(runes))
+	}
+	masked := strings.Repeat("*", len(runes)-prefixLength-suffixLength)
+	return string(runes[:prefixLength]) + masked + string(runes[len(runes)-suffixLength:])
+}
+
+// pointer helpers for clients whose API library doesn't provide them
+func String(value string) *string {
+	return &value
+}
+
+func Bool(value bool) *bool {
+	return &value
+}
@@ -0,0 +1,101 @@
+package common
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// the remaining quota below which requests move on to another token of the pool, if one has more left
+const TokenRotationThreshold = 100
+
+// provides an access token, which may change over time for tokens that expire
+type TokenSource interface {
+	Token() (string, error)
+}
+
+type StaticToken string
+
+func (t StaticToken) Token() (string, error) {
+	return string(t), nil
+}
+
+type pooledToken struct {
+	source    TokenSource
+	remaining int
+	reset     time.Time
+	known     bool
+}
+
+// a set of access tokens for the same code host that requests rotate through as their quotas run low
+type TokenPool struct {
+	sync.Mutex
+
+	tokens  []*pooledToken
+	current int
+}
+
+func NewTokenPool(sources ...TokenSource) *TokenPool {
+	pool := &TokenPool{}
+	for _, source := range sources {
+		pool.tokens = append(pool.tokens, &pooledToken{source: source})
+	}
+	return pool
+}
+
+func (p *TokenPool) Len() int {
+	return len(p.tokens)
+}
+
+// returns the token requests currently use along with its index in the pool
+func (p *TokenPool) Token() (string, int, error) {
+	p.Lock()
+	index := p.current
+	p.Unlock()
+	if len(p.tokens) == 0 {
+		return "", 0, errors.New("No access token available.")
+	}
+	token, err := p.tokens[index].source.Token()
+	return token, index, err
+}
+
+// records the quota a response reported for a token and rotates to another token when the current one
+// runs low, reporting whether it rotated
+func (p *TokenPool) Update(index int, remaining int, reset time.Time) bool {
+	p.Lock()
+	defer p.Unlock()
+	token := p.tokens[index]
+	token.remaining, token.reset, token.known = remaining, reset, true
+	if index != p.current || remaining > TokenRotationThreshold {
+		return false
+	}
+	best := p.current
+	for i := range p.tokens {
+		if p.available(i) > p.available(best) {
+			best = i
+		}
+	}
+	if best == p.current {
+		return false
+	}
+	p.current = best
+	return true
+}
+
+// returns the quota last reported for a token and whether any was reported yet
+func (p *TokenPool) Quota(index int) (int, time.Time, bool) {
+	p.Lock()
+	defer p.Unlock()
+	token := p.tokens[index]
+	return token.remaining, token.reset, token.known
+}
+
+// the quota a token is expected to have left, where tokens never used or whose quota reset are assumed to
+// have more left than any token in use
+func (p *TokenPool) available(index int) int {
+	token := p.tokens[index]
+	if !token.known || (!token.reset.IsZero() && time.Now().After(token.reset)) {
+		return int(^uint(0) >> 1)
+	}
+	return token.remaining
+}
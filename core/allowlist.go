@@ -0,0 +1,114 @@
+package core
+
+import (
+	"encoding/json"
+
+	"github.com/codeEmitter/gitrob/common"
+	"github.com/codeEmitter/gitrob/matching"
+	"github.com/codeEmitter/gitrob/store"
+)
+
+func (s *Session) InitAllowlist() {
+	path := *s.Options.Allowlist
+	for _, file := range matching.DefaultAllowlistFiles {
+		if path == "" && common.FileExists(file) {
+			path = file
+		}
+	}
+	if path == "" {
+		return
+	}
+	allowlist, err := matching.LoadAllowlist(path)
+	if err != nil {
+		s.Out.Fatal("Error loading allowlist: %s\n", err)
+	}
+	s.Allowlist = allowlist
+}
+
+// records a finding accepted by an allowlist rule apart from the other findings, so that it is only
+// counted unless suppressed findings are shown
+func (s *Session) SuppressFinding(finding *matching.Finding, rule *matching.AllowlistRule) {
+	s.Lock()
+	defer s.Unlock()
+	finding.SuppressedBy = rule.String()
+	if s.Store != nil {
+		found, err := s.isSuppressedInStore(finding.Id)
+		if err == nil && !found {
+			err = s.Store.Update(func(tx store.Tx) error {
+				return tx.Append(store.BucketSuppressed, finding)
+			})
+		}
+		if err != nil {
+			s.Out.Error("Error recording suppressed finding: %s\n", err)
+			return
+		}
+		if found {
+			return
+		}
+		s.suppressedIds[finding.Id] = true
+	} else {
+		for _, suppressed := range s.Suppressed {
+			//commits interrupted mid-analysis are analyzed again when a session is resumed
+			if suppressed.Id == finding.Id {
+				return
+			}
+		}
+		s.Suppressed = append(s.Suppressed, finding)
+	}
+	s.Out.Debug(" SUPPRESSED: %s in %s (commit %s) by %s\n", finding.ContentSignatureDescription,
+		finding.FilePath, finding.CommitHash, finding.SuppressedBy)
+	s.Stats.IncrementSuppressed()
+}
+
+// reports whether the database holds a finding suppressed before, which happens when commits interrupted
+// mid-analysis are analyzed again after resuming
+func (s *Session) isSuppressedInStore(id string) (bool, error) {
+	if s.suppressedIds == nil {
+		suppressedIds := make(map[string]bool)
+		err := s.Store.View(func(tx store.Tx) error {
+			return tx.ForEach(store.BucketSuppressed, func(data []byte) error {
+				finding := &matching.Finding{}
+				if err := json.Unmarshal(data, finding); err != nil {
+					return err
+				}
+				suppressedIds[finding.Id] = true
+				return nil
+			})
+		})
+		if err != nil {
+			return false, err
+		}
+		s.suppressedIds = suppressedIds
+	}
+	return s.suppressedIds[id], nil
+}
+
+func (s *Session) GetSuppressedFindings() ([]*matching.Finding, error) {
+	return s.GetSuppressedFindingsPage(0, 0)
+}
+
+// returns at most limit suppressed findings from the offset on, in the order they were recorded, or all
+// of them when limit is not positive
+func (s *Session) GetSuppressedFindingsPage(offset int, limit int) ([]*matching.Finding, error) {
+	if s.Store == nil {
+		s.Lock()
+		defer s.Unlock()
+		start, end := pageBounds(len(s.Suppressed), offset, limit)
+		return append([]*matching.Finding{}, s.Suppressed[start:end]...), nil
+	}
+	return s.findingsPageFromStore(store.BucketSuppressed, offset, limit)
+}
+
+// returns the findings shown in the web interface and reports, which include the suppressed ones
+// only when asked to
+func (s *Session) GetVisibleFindings() ([]*matching.Finding, error) {
+	findings, err := s.GetFindings()
+	if err != nil || !*s.Options.ShowSuppressed {
+		return findings, err
+	}
+	suppressed, err := s.GetSuppressedFindings()
+	if err != nil {
+		return nil, err
+	}
+	return append(append([]*matching.Finding{}, findings...), suppressed...), nil
+}
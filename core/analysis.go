@@ -3,12 +3,14 @@ package core
 import (
 	"fmt"
 	"github.com/codeEmitter/gitrob/common"
-	"github.com/codeEmitter/gitrob/github"
-	"github.com/codeEmitter/gitrob/gitlab"
+	"github.com/codeEmitter/gitrob/local"
 	"github.com/codeEmitter/gitrob/matching"
 	"gopkg.in/src-d/go-git.v4"
+	"gopkg.in/src-d/go-git.v4/plumbing"
 	"gopkg.in/src-d/go-git.v4/plumbing/object"
+	"gopkg.in/src-d/go-git.v4/plumbing/transport"
 	"os"
+	"sort"
 	"strings"
 	"sync"
 )
@@ -18,7 +20,14 @@ func PrintSessionStats(sess *Session) {
 	sess.Out.Info("Files.......: %d\n", sess.Stats.Files)
 	sess.Out.Info("Commits.....: %d\n", sess.Stats.Commits)
 	sess.Out.Info("Repositories: %d\n", sess.Stats.Repositories)
-	sess.Out.Info("Targets.....: %d\n\n", sess.Stats.Targets)
+	sess.Out.Info("Targets.....: %d\n", sess.Stats.Targets)
+	if sess.Stats.Skipped.Total() > 0 {
+		sess.Out.Info("Skipped.....: %s\n", sess.Stats.Skipped)
+	}
+	if sess.Stats.Suppressed > 0 {
+		sess.Out.Info("Suppressed..: %d\n", sess.Stats.Suppressed)
+	}
+	sess.Out.Info("\n")
 }
 
 func GatherTargets(sess *Session) {
@@ -26,24 +35,53 @@ func GatherTargets(sess *Session) {
 	sess.Out.Important("Gathering targets...\n")
 
 	for _, loginOption := range sess.Options.Logins {
-		target, err := sess.Client.GetUserOrOrganization(loginOption)
+		provider, login, err := sess.TargetProvider(loginOption)
+		if err != nil {
+			sess.Out.Error(" %s\n", err)
+			continue
+		}
+		//GitHub only tells public and private repositories apart, so none would be analyzed
+		if provider.Type == common.SourceTypeGithub && sess.Filter.Visibility == common.VisibilityInternal {
+			sess.Out.Error(" GitHub target %s has no repositories of internal visibility, only public or private ones.\n", loginOption)
+			continue
+		}
+		target, err := provider.Client.GetUserOrOrganization(login)
 		if err != nil || target == nil {
 			sess.Out.Error(" Error retrieving information on %s: %s\n", loginOption, err)
 			continue
 		}
 		sess.Out.Debug("%s (ID: %d) type: %s\n", *target.Login, *target.ID, *target.Type)
-		sess.AddTarget(target)
-		if *sess.Options.NoExpandOrgs == false && *target.Type == common.TargetTypeOrganization {
-			sess.Out.Debug("Gathering members of %s (ID: %d)...\n", *target.Login, *target.ID)
-			members, err := sess.Client.GetOrganizationMembers(*target)
-			if err != nil {
-				sess.Out.Error(" Error retrieving members of %s: %s\n", *target.Login, err)
-				continue
-			}
-			for _, member := range members {
-				sess.Out.Debug("Adding organization member %s (ID: %d) to targets\n", *member.Login, *member.ID)
-				sess.AddTarget(member)
-			}
+		addOrganizationTarget(sess, provider, target)
+	}
+}
+
+// adds the target and, for organizations, their members and the whole hierarchy of their subgroups
+func addOrganizationTarget(sess *Session, provider *Provider, target *common.Owner) {
+	target.SourceType = &provider.Type
+	//a target given twice, or a subgroup also given as a target, is only expanded once
+	if !sess.AddTarget(target) || *target.Type != common.TargetTypeOrganization {
+		return
+	}
+	if *sess.Options.NoExpandOrgs == false {
+		sess.Out.Debug("Gathering members of %s (ID: %d)...\n", *target.Login, *target.ID)
+		members, err := provider.Client.GetOrganizationMembers(*target)
+		if err != nil {
+			sess.Out.Error(" Error retrieving members of %s: %s\n", *target.Login, err)
+		}
+		for _, member := range members {
+			sess.Out.Debug("Adding organization member %s (ID: %d) to targets\n", *member.Login, *member.ID)
+			member.SourceType = &provider.Type
+			sess.AddTarget(member)
+		}
+	}
+	if *sess.Options.NoExpandSubgroups == false {
+		subgroups, err := provider.Client.GetSubgroups(*target)
+		if err != nil {
+			sess.Out.Error(" Error retrieving subgroups of %s: %s\n", *target.Login, err)
+		}
+		for _, subgroup := range subgroups {
+			sess.Out.Debug("Adding subgroup %s (ID: %d) to targets\n", *subgroup.Login, *subgroup.ID)
+			addOrganizationTarget(sess, provider, subgroup)
 		}
 	}
 }
@@ -52,8 +90,9 @@ func GatherRepositories(sess *Session) {
 	var ch = make(chan *common.Owner, len(sess.Targets))
 	var wg sync.WaitGroup
 	var threadNum int
-	if len(sess.Targets) == 1 {
-		threadNum = 1
+	//no targets are left when every one given was rejected
+	if len(sess.Targets) <= 1 {
+		threadNum = len(sess.Targets)
 	} else if len(sess.Targets) <= *sess.Options.Threads {
 		threadNum = len(sess.Targets) - 1
 	} else {
@@ -69,19 +108,37 @@ func GatherRepositories(sess *Session) {
 					wg.Done()
 					return
 				}
-				repos, err := sess.Client.GetRepositoriesFromOwner(*target)
+				provider, err := sess.Provider(target.SourceType)
+				if err != nil {
+					sess.Out.Error(" Failed to retrieve repositories from %s: %s\n", *target.Login, err)
+					continue
+				}
+				repos, err := provider.Client.GetRepositoriesFromOwner(*target)
 				if err != nil {
 					sess.Out.Error(" Failed to retrieve repositories from %s: %s\n", *target.Login, err)
 				}
 				if len(repos) == 0 {
 					continue
 				}
+				filtered := 0
 				for _, repo := range repos {
+					repo.SourceType = &provider.Type
+					//filter before cloning rather than analyzing repositories only to discard them
+					if reason := sess.Filter.Reason(repo); reason != "" {
+						sess.Out.Debug(" Filtered repository: %s (%s)\n", *repo.CloneURL, reason)
+						filtered++
+						continue
+					}
 					sess.Out.Debug(" Retrieved repository: %s\n", *repo.CloneURL)
 					sess.AddRepository(repo)
 				}
 				sess.Stats.IncrementTargets()
-				sess.Out.Info(" Retrieved %d %s from %s\n", len(repos), common.Pluralize(len(repos), "repository", "repositories"), *target.Login)
+				if filtered > 0 {
+					sess.Out.Info(" Retrieved %d %s from %s, %d filtered\n", len(repos)-filtered,
+						common.Pluralize(len(repos)-filtered, "repository", "repositories"), *target.Login, filtered)
+				} else {
+					sess.Out.Info(" Retrieved %d %s from %s\n", len(repos), common.Pluralize(len(repos), "repository", "repositories"), *target.Login)
+				}
 			}
 		}()
 	}
@@ -93,12 +150,15 @@ func GatherRepositories(sess *Session) {
 	wg.Wait()
 }
 
-func createFinding(repo common.Repository,
+func createFinding(sess *Session,
+	repo common.Repository,
 	commit object.Commit,
+	refs []string,
 	change *object.Change,
 	fileSignature matching.FileSignature,
 	contentSignature matching.ContentSignature,
-	isGitHubSession bool) *matching.Finding {
+	contentMatch *matching.ContentMatch,
+	secrets []string) *matching.Finding {
 
 	finding := &matching.Finding{
 		FilePath:                    common.GetChangePath(change),
@@ -109,49 +169,161 @@ func createFinding(repo common.Repository,
 		ContentSignatureComment:     contentSignature.GetComment(),
 		RepositoryOwner:             *repo.Owner,
 		RepositoryName:              *repo.Name,
+		RepositoryId:                *repo.ID,
 		CommitHash:                  commit.Hash.String(),
+		CommitDate:                  commit.Committer.When,
 		CommitMessage:               strings.TrimSpace(commit.Message),
 		CommitAuthor:                commit.Author.String(),
 		CloneUrl:                    *repo.CloneURL,
+		Refs:                        refs,
+	}
+	if contentMatch == nil {
+		finding.Severity = fileSignature.Severity
+	} else {
+		finding.Severity = matching.HighestSeverity(fileSignature.Severity, contentSignature.Severity)
+		finding.LineNumber = contentMatch.NewLineNumber
+		finding.OldLineNumber = contentMatch.OldLineNumber
+		finding.Removed = contentMatch.Removed
+		finding.Secret = redact(sess, contentMatch.Text)
+		finding.Entropy = contentMatch.Entropy
+		for _, line := range contentMatch.Context {
+			finding.Context = append(finding.Context, redactLine(sess, line, secrets))
+		}
+	}
+	sourceType := common.SourceTypeGithub
+	if provider, err := sess.Provider(repo.SourceType); err == nil {
+		sourceType = provider.Type
+	}
+	finding.Initialize(sourceType, sess.WebUrl(sourceType))
+	secret := ""
+	if contentMatch != nil {
+		secret = contentMatch.Secret
 	}
-	finding.Initialize(isGitHubSession)
+	finding.GenerateFingerprint(secret, *sess.Options.FingerprintPath)
 	return finding
 
 }
 
+// redacts the secrets matched in a file wherever they occur on a line of context, so that a secret next to the
+// one a finding is about is not shown either; text no signature matched is shown as is
+func redactLine(sess *Session, line string, secrets []string) string {
+	for _, secret := range secrets {
+		//a match can span several lines, so redact each of its lines individually
+		for _, secretLine := range strings.Split(secret, "\n") {
+			if secretLine != "" {
+				line = strings.Replace(line, secretLine, redact(sess, secretLine), -1)
+			}
+		}
+	}
+	return line
+}
+
+func redact(sess *Session, secret string) string {
+	if *sess.Options.NoRedact {
+		return secret
+	}
+	return common.RedactString(secret, *sess.Options.RedactPrefix, *sess.Options.RedactSuffix)
+}
+
 func matchContent(sess *Session,
 	matchTarget matching.MatchTarget,
 	repo common.Repository,
 	change *object.Change,
 	commit object.Commit,
+	refs []string,
 	fileSignature matching.FileSignature,
+	skipRules *matching.SkipRuleSet,
 	threadId int) {
 
-	content, err := common.GetChangeContent(change)
+	//the patch is read once, both to sniff binary files and to match the changed lines
+	var patch *object.Patch
+	var err error
+	isBinary := func() (bool, error) {
+		if patch, err = change.Patch(); err != nil {
+			return false, err
+		}
+		return common.IsBinaryPatch(patch), nil
+	}
+	if reason := contentSkipReason(sess, skipRules, change, isBinary); reason != "" {
+		sess.Out.Debug("[THREAD #%d][%s] Skipping content of %s (%s)\n", threadId, *repo.CloneURL, matchTarget.Path, reason)
+		sess.Stats.IncrementSkipped(reason)
+		return
+	}
+	if patch == nil {
+		patch, err = change.Patch()
+	}
 	if err != nil {
 		sess.Out.Error("Error retrieving content in commit %s, change %s.", commit.String(), change.String())
+		return
 	}
-	matchTarget.Content = content
+	matchTarget.SetLines(common.GetPatchLines(patch), *sess.Options.ScanRemovedLines)
 	sess.Out.Debug("[THREAD #%d][%s] Matching content in %s...\n", threadId, *repo.CloneURL, commit.Hash)
-	for _, contentSignature := range sess.Signatures.ContentSignatures {
-		matched, err := contentSignature.Match(matchTarget)
+	//every signature is matched before findings are created, to redact all secrets in the context of each
+	var signatures []matching.ContentSignature
+	var matches [][]matching.ContentMatch
+	var secrets []string
+	for _, contentSignature := range sess.Signatures.ContentSignaturesFor(matchTarget.Content) {
+		signatureMatches, err := contentSignature.Match(matchTarget)
 		if err != nil {
 			sess.Out.Error("Error while performing content match with '%s': %s\n", contentSignature.Description, err)
 		}
-		if !matched {
-			continue
+		signatures = append(signatures, contentSignature)
+		matches = append(matches, signatureMatches)
+		for _, match := range signatureMatches {
+			secrets = append(secrets, match.Text)
+		}
+	}
+	//longer secrets first, so that a secret within another is not redacted on its own first
+	sort.SliceStable(secrets, func(i, j int) bool {
+		return len(secrets[i]) > len(secrets[j])
+	})
+	for i, contentSignature := range signatures {
+		for j := range matches[i] {
+			finding := createFinding(sess, repo, commit, refs, change, fileSignature, contentSignature, &matches[i][j], secrets)
+			addFinding(sess, finding)
 		}
-		finding := createFinding(repo, commit, change, fileSignature, contentSignature, sess.IsGithubSession)
-		sess.AddFinding(finding)
 	}
 }
 
-func findSecrets(sess *Session, repo *common.Repository, commit *object.Commit, changes object.Changes, threadId int) {
+// adds the finding to the session unless the allowlist accepts it
+func addFinding(sess *Session, finding *matching.Finding) {
+	if rule := sess.Allowlist.Match(finding); rule != nil {
+		sess.SuppressFinding(finding, rule)
+		return
+	}
+	sess.AddFinding(finding)
+}
+
+// returns the reason the content of the changed file is not matched, judged by the size of the file as of
+// the change, or as of before it for deletions, and by isBinary
+func contentSkipReason(sess *Session, skipRules *matching.SkipRuleSet, change *object.Change, isBinary func() (bool, error)) string {
+	from, to, err := change.Files()
+	if err != nil {
+		sess.Out.Error("Error retrieving files of change %s: %s\n", change.String(), err)
+		return ""
+	}
+	file := to
+	if file == nil {
+		file = from
+	}
+	if file == nil {
+		return ""
+	}
+	reason, err := skipRules.ContentSkipReason(file.Size, isBinary)
+	if err != nil {
+		sess.Out.Error("Error inspecting %s: %s\n", file.Name, err)
+	}
+	return reason
+}
+
+func findSecrets(sess *Session, repo *common.Repository, commit *object.Commit, refs []string, changes object.Changes, threadId int) {
+	skipRules := sess.SkipRules.For(*repo.Owner, *repo.Name)
 	for _, change := range changes {
 		path := common.GetChangePath(change)
 		matchTarget := matching.NewMatchTarget(path)
-		if matchTarget.IsSkippable() {
-			sess.Out.Debug("[THREAD #%d][%s] Skipping %s\n", threadId, *repo.CloneURL, matchTarget.Path)
+		if reason := skipRules.PathSkipReason(matchTarget); reason != "" {
+			sess.Out.Debug("[THREAD #%d][%s] Skipping %s (%s)\n", threadId, *repo.CloneURL, matchTarget.Path, reason)
+			sess.Stats.IncrementSkipped(reason)
 			continue
 		}
 		sess.Out.Debug("[THREAD #%d][%s] Inspecting file: %s...\n", threadId, *repo.CloneURL, matchTarget.Path)
@@ -166,18 +338,18 @@ func findSecrets(sess *Session, repo *common.Repository, commit *object.Commit,
 					continue
 				}
 				if *sess.Options.Mode == 1 {
-					finding := createFinding(*repo, *commit, change, fileSignature,
-						matching.ContentSignature{Description: "NA"}, sess.IsGithubSession)
-					sess.AddFinding(finding)
+					finding := createFinding(sess, *repo, *commit, refs, change, fileSignature,
+						matching.ContentSignature{Description: "NA"}, nil, nil)
+					addFinding(sess, finding)
 				}
 				if *sess.Options.Mode == 2 {
-					matchContent(sess, matchTarget, *repo, change, *commit, fileSignature, threadId)
+					matchContent(sess, matchTarget, *repo, change, *commit, refs, fileSignature, skipRules, threadId)
 				}
 				break
 			}
 			sess.Stats.IncrementFiles()
 		} else {
-			matchContent(sess, matchTarget, *repo, change, *commit, matching.FileSignature{Description: "NA"}, threadId)
+			matchContent(sess, matchTarget, *repo, change, *commit, refs, matching.FileSignature{Description: "NA"}, skipRules, threadId)
 			sess.Stats.IncrementFiles()
 		}
 	}
@@ -186,39 +358,65 @@ func findSecrets(sess *Session, repo *common.Repository, commit *object.Commit,
 func cloneRepository(sess *Session, repo *common.Repository, threadId int) (*git.Repository, string, error) {
 	sess.Out.Debug("[THREAD #%d][%s] Cloning repository...\n", threadId, *repo.CloneURL)
 
+	provider, err := sess.Provider(repo.SourceType)
+	if err != nil {
+		sess.Out.Error("Error cloning repository %s: %s\n", *repo.CloneURL, err)
+		sess.Stats.IncrementRepositories()
+		sess.Stats.UpdateProgress(sess.Stats.Repositories, len(sess.Repositories))
+		return nil, "", err
+	}
+	//repositories gathered before SSH URLs were recorded are cloned over HTTPS
+	url, auth := repo.CloneURL, transport.AuthMethod(nil)
+	if sess.SSHAuth != nil && provider.Type != common.SourceTypeLocal && repo.SSHCloneURL != nil && *repo.SSHCloneURL != "" {
+		url, auth = repo.SSHCloneURL, sess.SSHAuth
+	} else if auth, err = provider.HttpAuth(); err != nil {
+		sess.Out.Error("Error getting access token: %s\n", err)
+	}
 	cloneConfig := common.CloneConfiguration{
-		Url:    repo.CloneURL,
-		Branch: repo.DefaultBranch,
-		Depth:  sess.Options.CommitDepth,
-		Token:  &sess.GitLab.AccessToken,
+		Url:        url,
+		Auth:       auth,
+		Branch:     repo.DefaultBranch,
+		Depth:      sess.Options.CommitDepth,
 		InMemClone: sess.Options.InMemClone,
+		AllRefs:    sess.Options.AllRefs,
 	}
 
 	var clone *git.Repository
 	var path string
-	var err error
 
-	if sess.IsGithubSession {
-		clone, path, err = github.CloneRepository(&cloneConfig)
-	} else {
-		userName := "oauth2"
-		cloneConfig.Username = &userName
-		clone, path, err = gitlab.CloneRepository(&cloneConfig)
+	switch provider.Type {
+	case common.SourceTypeLocal:
+		clone, path, err = local.CloneRepository(&cloneConfig)
+	default:
+		clone, path, err = common.CloneRepository(&cloneConfig)
 	}
 	if err != nil {
 		if err.Error() != "remote repository is empty" {
-			sess.Out.Error("Error cloning repository %s: %s\n", *repo.CloneURL, err)
+			sess.Out.Error("Error cloning repository %s: %s\n", *url, err)
 		}
 		sess.Stats.IncrementRepositories()
 		sess.Stats.UpdateProgress(sess.Stats.Repositories, len(sess.Repositories))
 		return nil, "", err
 	}
 	sess.Out.Debug("[THREAD #%d][%s] Cloned repository to: %s\n", threadId, *repo.CloneURL, path)
+	if refSpec := pullRequestRefSpecs[provider.Type]; *sess.Options.PullRequests && refSpec != "" {
+		//the branches are still analyzed when the pull requests can't be fetched
+		if err := common.FetchRefs(clone, refSpec, *sess.Options.CommitDepth, auth); err != nil {
+			sess.Out.Error("Error fetching pull requests of %s, analyzing its branches only: %s\n", *url, err)
+		}
+	}
 	return clone, path, err
 }
 
-func getRepositoryHistory(sess *Session, clone *git.Repository, repo *common.Repository, path string, threadId int) ([]*object.Commit, error) {
-	history, err := common.GetRepositoryHistory(clone)
+func getRepositoryHistory(sess *Session, clone *git.Repository, repo *common.Repository, path string, threadId int) ([]*object.Commit, map[plumbing.Hash][]string, error) {
+	var history []*object.Commit
+	var refs map[plumbing.Hash][]string
+	var err error
+	if *sess.Options.AllRefs || *sess.Options.PullRequests {
+		history, refs, err = common.GetRepositoryHistoryFromRefs(clone)
+	} else {
+		history, err = common.GetRepositoryHistory(clone)
+	}
 	if err != nil {
 		sess.Out.Error("[THREAD #%d][%s] Error getting commit history: %s\n", threadId, *repo.CloneURL, err)
 		if *sess.Options.InMemClone {
@@ -226,10 +424,35 @@ func getRepositoryHistory(sess *Session, clone *git.Repository, repo *common.Rep
 		}
 		sess.Stats.IncrementRepositories()
 		sess.Stats.UpdateProgress(sess.Stats.Repositories, len(sess.Repositories))
-		return nil, err
+		return nil, nil, err
 	}
 	sess.Out.Debug("[THREAD #%d][%s] Number of commits: %d\n", threadId, *repo.CloneURL, len(history))
-	return history, err
+	return history, refs, err
+}
+
+// leaves out the commits a fork shares with its upstream, which are reported when analyzing the upstream
+func excludeUpstreamHistory(sess *Session, clone *git.Repository, repo *common.Repository, history []*object.Commit, threadId int) []*object.Commit {
+	//the upstream is fetched over HTTPS, as only its HTTPS URL is known
+	var auth transport.AuthMethod
+	if provider, err := sess.Provider(repo.SourceType); err == nil {
+		if auth, err = provider.HttpAuth(); err != nil {
+			sess.Out.Error("Error getting access token: %s\n", err)
+		}
+	}
+	upstream, err := common.GetUpstreamCommits(clone, *repo.ParentCloneURL, *sess.Options.CommitDepth, auth)
+	if err != nil {
+		sess.Out.Error("Error fetching upstream %s of fork %s, analyzing its whole history: %s\n",
+			*repo.ParentCloneURL, *repo.CloneURL, err)
+		return history
+	}
+	var forkHistory []*object.Commit
+	for _, commit := range history {
+		if !upstream[commit.Hash] {
+			forkHistory = append(forkHistory, commit)
+		}
+	}
+	sess.Out.Debug("[THREAD #%d][%s] %d commits not in upstream %s\n", threadId, *repo.CloneURL, len(forkHistory), *repo.ParentCloneURL)
+	return forkHistory
 }
 
 func AnalyzeRepositories(sess *Session) {
@@ -265,19 +488,24 @@ func AnalyzeRepositories(sess *Session) {
 					continue
 				}
 
-				history, err := getRepositoryHistory(sess, clone, repo, path, tid)
+				history, refs, err := getRepositoryHistory(sess, clone, repo, path, tid)
 				if err != nil {
 					continue
 				}
+				if repo.ParentCloneURL != nil && *repo.ParentCloneURL != "" {
+					history = excludeUpstreamHistory(sess, clone, repo, history, tid)
+				}
+				history = sess.RemainingHistory(repo, history)
 
 				for _, commit := range history {
 					sess.Out.Debug("[THREAD #%d][%s] Analyzing commit: %s\n", tid, *repo.CloneURL, commit.Hash)
 					changes, _ := common.GetChanges(commit, clone)
 					sess.Out.Debug("[THREAD #%d][%s] %s changes in %d\n", tid, *repo.CloneURL, commit.Hash, len(changes))
 
-					findSecrets(sess, repo, commit, changes, tid)
+					findSecrets(sess, repo, commit, refs[commit.Hash], changes, tid)
 
 					sess.Stats.IncrementCommits()
+					sess.CheckpointCommit(repo, commit)
 					sess.Out.Debug("[THREAD #%d][%s] Done analyzing changes in %s\n", tid, *repo.CloneURL, commit.Hash)
 				}
 
@@ -288,10 +516,15 @@ func AnalyzeRepositories(sess *Session) {
 				sess.Out.Debug("[THREAD #%d][%s] Deleted %s\n", tid, *repo.CloneURL, path)
 				sess.Stats.IncrementRepositories()
 				sess.Stats.UpdateProgress(sess.Stats.Repositories, len(sess.Repositories))
+				sess.CompleteRepository(repo)
 			}
 		}(i)
 	}
 	for _, repo := range sess.Repositories {
+		if sess.IsRepositoryCompleted(repo) {
+			sess.Out.Debug("Skipping %s, completed before the session was resumed\n", *repo.CloneURL)
+			continue
+		}
 		ch <- repo
 	}
 	close(ch)
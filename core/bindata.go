@@ -261,7 +261,7 @@ func staticImagesSpinnerGif() (*asset, error) {
 	return a, nil
 }
 
-var _staticIndexHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x5a\x7b\x6f\xdc\xb8\x11\xff\xff\x3e\xc5\x1c\x8b\x3d\x24\xc0\x69\xe5\xd4\xc0\xa1\x70\xa4\x45\xd3\x38\xd7\x18\xb8\x24\x87\xc4\x2d\xd0\xbf\x0c\x4a\x9c\x95\x18\x53\xa4\x4a\x72\xbd\x76\x8b\x7e\xf7\x82\x0f\x69\xf5\xda\x5c\xd6\x49\x80\x00\x81\x23\x92\xf3\xe2\x8f\xc3\xe1\x0c\xb9\xd9\x8f\x4c\x95\xf6\xa1\x45\xa8\x6d\x23\x36\x3f\x64\xee\x3f\x10\x54\x56\x39\x41\x49\x36\x3f\x00\x64\x35\x52\xe6\x3e\x00\xb2\x06\x2d\x85\xb2\xa6\xda\xa0\xcd\xc9\xce\x6e\x93\xbf\x90\xe1\x90\xa4\x0d\xe6\xe4\x8e\xe3\xbe\x55\xda\x12\x28\x95\xb4\x28\x6d\x4e\xf6\x9c\xd9\x3a\x67\x78\xc7\x4b\x4c\x7c\xe3\x67\xe0\x92\x5b\x4e\x45\x62\x4a\x2a\x30\x7f\xf6\x33\x98\x5a\x73\x79\x9b\x58\x95\x6c\xb9\xcd\xa5\x5a\x10\xcd\xd0\x94\x9a\xb7\x96\x2b\x39\x90\xfe\x77\x6e\xb5\x2a\x2e\xe0\xf7\x9d\xb5\x5c\x56\x60\x6b\x84\x77\x2d\x4a\xf8\xa0\x76\xba\x44\xe0\x12\xde\x7d\xb8\x7a\x7b\xbd\x20\x90\xee\x6c\xad\xf4\x40\xd6\x1b\x5e\xd6\x14\x05\xbc\x46\xa9\xf9\xad\x41\x09\x4f\xfe\xda\xf0\xb2\xee\x9a\x4f\xc9\xe6\x87\x20\xc5\x72\x2b\x70\x13\x74\x67\x69\x68\xc5\x21\xc1\xe5\x2d\xd4\x1a\xb7\x39\x49\x8d\x7d\x10\x68\x6a\x44\x6b\xd2\x42\x29\x6b\xac\xa6\xed\xba\x34\x86\x80\x46\x91\x93\xc3\x78\x67\xde\x31\x6e\xd5\xa2\xe4\xa5\x92\xbc\x7c\x14\x7b\xcd\xab\x5a\xf0\xaa\xb6\x8f\xe2\xa6\x6d\x2b\x78\x49\x1d\xf2\xc7\xf9\xb3\x34\x38\x8b\xfb\x2c\x14\x7b\xe8\xf0\x90\xf4\x0e\x4a\x41\x8d\xc9\x89\xa4\x77\x05\xd5\x10\xfe\x4b\xf0\xbe\xa5\x92\x25\x0d\xeb\x3a\xbc\x81\x50\x54\xe1\x23\x1a\x05\x90\x31\xde\x4b\x70\x4b\x45\xb9\x44\xdd\x8f\x02\x64\x74\x2c\x3f\x29\x34\x95\x8c\x74\x13\x19\x52\xf2\xa6\x02\xa3\xcb\x9c\xa4\xbc\xa1\x15\x9a\xb4\x52\x6d\x8d\xfa\xc6\x59\xbe\x6e\x65\x45\x20\x38\x2b\x39\x3f\x23\x50\xa3\x33\x23\x27\x7f\x3e\x23\x9d\x02\x96\x70\x29\xb8\xc4\xa4\x10\xaa\xbc\x25\x40\x85\xcd\xc9\x40\x41\xe7\x10\x74\xa0\xb3\xd8\x59\xab\xe4\xc4\x44\xab\xaa\x4a\xa0\x26\xe0\xf6\x5f\x4e\x02\x0d\x01\x46\x2d\x8d\x63\x6e\xae\x42\xd0\xd6\x20\x01\xaa\x39\x8d\x70\x21\xcb\xc9\x96\x8a\xbe\x57\xd0\xc2\xad\xc5\xb5\xe7\x71\x40\xf2\xca\xaf\xd3\xc0\x28\x80\xcc\xb4\xf4\x88\x05\x89\x73\x2a\xb2\xc9\x52\x47\x32\xb0\x3a\x0d\x26\xf5\x6b\x90\x32\x7e\x17\xbd\x24\x95\xf4\xae\x5b\xdc\x86\x72\x09\x5a\x39\x73\xdd\x27\x39\xbe\x4e\x59\xa1\x21\x1d\x2d\x29\x67\xce\x87\xa8\x35\x37\x8b\xab\x3a\x58\xf5\x56\xab\x4a\xa3\x73\x3c\xef\x73\x39\x09\x4b\x73\x01\xe7\x67\xed\xfd\xf3\xf1\x54\x17\xd8\x12\xe7\x74\xc3\x46\x62\xac\xe6\x2d\xb2\x71\x27\x95\xbc\xa1\x16\x19\x89\x13\xea\x06\x0b\xaa\x89\x37\xb6\xeb\xb8\xf1\x3d\xd1\x14\xef\x30\x17\xf0\xec\xec\x6c\xf5\x3c\xae\xc9\x1d\x15\x3b\x94\x6a\x9f\x93\x67\x67\x67\xc3\xbe\x86\xcb\x9c\x8c\x7b\xe8\x7d\xa0\xda\x5c\x85\x88\xc8\xff\xc3\x65\xb5\x5e\xaf\x07\x80\x4f\xf0\x9f\x81\x39\x9e\xb4\x56\xfb\xa3\x80\x94\x4a\x24\xa6\x19\x0d\x4f\x08\xa8\x66\x60\xf1\xde\x26\x25\x4a\x8b\x71\xde\xae\xf7\x66\xcb\x25\xe3\xb2\x32\x13\xee\x39\x7f\xe2\x36\xff\x8c\xca\x9d\x25\xe7\x23\x32\x1f\x34\x17\x14\xdc\x78\x60\xc8\xe6\x2c\x4b\xeb\xf3\x05\x31\xed\x58\x0a\xde\xdb\x25\x21\xee\xb0\x20\x9b\x5f\x63\x33\x4b\xdb\x99\xd9\x63\x44\x17\xbb\xe6\x1d\x5f\x0d\x4c\x81\xdf\x12\x49\x81\x5f\x0a\xa3\x93\xd0\x61\x28\xf0\xbb\x03\xb0\x54\x4d\xc3\xed\xb7\x83\x30\xca\xff\x22\x10\x3b\x19\x01\xc6\x97\xa1\xf5\xbd\x01\xa9\xb1\x55\x86\x5b\xa5\xf9\x37\x74\xc8\xa1\x92\x2f\x82\x74\x24\x28\xe0\xfa\x7e\xd0\xf5\xbd\x81\x6b\xa9\xae\xf0\x1b\x7a\x69\x94\xff\x45\x90\x76\x32\x02\x9a\xd7\xa1\xf5\xbd\x01\xc9\x76\x7a\x9e\xd5\x7c\x4d\x24\x3b\x05\x3d\x94\x67\x17\xfe\xdf\x63\x10\xed\x65\x05\x48\x2f\x63\xf3\xeb\x60\x3a\x6a\xc6\xc6\x38\xc3\xea\x5a\x06\x4b\xa7\x36\x64\x2e\xb4\xc2\xa5\x13\x3c\x1b\xcf\xae\x3b\x2e\x87\xea\xb9\x6c\x77\xb6\x9b\xee\x56\xe9\x26\x71\xd9\x9a\x56\x02\x86\x8d\xc4\x34\xb0\x15\x8a\xda\x44\xfb\xdc\x3d\xe6\xb5\x01\x99\x56\xd0\x12\x6b\x25\x18\xea\x9c\x7c\x40\xaa\xcb\x7a\xbd\x5e\x07\xc4\xfa\x03\xdb\xf8\xfe\xa1\x6d\x1e\xfa\x43\xd3\xd2\x42\x60\x67\x48\x68\xf8\xbf\x4e\x75\xf8\xa8\xd5\x1d\xea\xae\x33\x64\x78\x41\x89\xef\x5a\xce\x60\x32\x7b\x28\x71\x0f\x7d\x7a\xb6\x52\xb6\x06\x53\xaa\x36\xa4\xe5\x64\xe8\xd3\xb4\x0c\x9e\xf9\xa2\x0c\xab\x6c\xeb\x13\x98\x5b\x6a\x6b\xb2\xf9\x9d\xda\xfa\x44\xc6\x70\xb8\x74\xc7\xca\x89\xcc\x7d\x18\x7d\x18\xc4\xcf\x87\xb9\x90\x2c\x1d\x23\xe1\x28\x26\x68\x65\x36\xd4\x7a\x23\xa2\x71\x57\x96\x7a\xfc\x0f\x4e\x1b\x3d\xb3\x2b\x27\x5c\xe1\xb0\xc9\x7e\x4c\x12\x48\xd7\x7d\x25\x00\x49\xd2\xd5\x18\x5b\xa5\x2c\xea\x4f\x56\x83\xc3\xb0\x11\xbe\x9b\x9d\xcb\xe4\x47\x45\x62\xa8\x07\x6b\x6b\x5b\x73\x91\xa6\x15\xb7\xf5\xae\x58\x97\xaa\x49\x87\x25\xbe\xeb\xd7\xaa\x20\x10\xe2\x62\x4e\x6e\x0a\x41\xe5\x2d\xd9\x1c\x4a\x3b\xe0\x06\xa8\x2b\x1d\x3e\x62\x69\xa1\x78\x18\xcb\xbe\x48\x47\xf2\x9c\x82\xb9\xb0\xd9\x45\x83\x97\xfb\x53\xc3\x19\x53\xf6\xf9\xa9\xc6\xa6\xdc\x98\x1d\x9a\x54\xe2\x7e\xae\xca\xad\xaf\xb6\x40\x25\x78\xaa\x41\x6d\x3a\xaa\xe9\x3a\x90\x43\x33\x5c\xb4\x0c\x36\x71\x6a\xb1\x69\x05\xb5\x31\x66\x76\xad\x6e\x4f\x1d\xaa\x3c\xcb\x96\xf6\xc6\x61\x19\x56\xc0\xb7\xf0\x24\xec\x15\xc8\x73\x20\x6f\x14\xe3\xdb\x07\xf2\x14\xfe\x0b\xab\xa3\x35\x6b\x41\x59\x85\xe0\xff\x26\xad\xe6\x0d\x75\x9e\xfb\xe6\xdd\xe5\xd5\xaf\xff\x9a\x55\xae\x2b\xf8\x1f\xa0\x30\x38\x55\x74\x25\x0d\x6a\x7b\x82\x22\xb3\x2b\x4b\x57\x74\x6e\x5e\xbe\x7f\xf5\xe2\xfa\xd5\x67\x2b\xba\x44\x81\x16\x4f\x50\xc4\xa8\xac\x5c\xed\x7b\xf9\xea\xb7\x57\x47\xf4\xac\x0e\x8b\x66\xd9\x11\xb0\x43\x2c\xc9\x4a\xc5\x70\xc1\xef\xff\x44\x36\xd9\x2a\x07\x5b\x73\xb3\x76\x91\x9b\x5a\x8b\xcc\xe5\xf6\x2e\xf8\x3c\x79\x0a\xab\xcd\xc8\x35\xbc\x94\x4f\x28\xeb\xe2\x4f\x50\xd7\x6b\xc9\x56\x09\x84\x90\xf4\x0f\x2d\x60\xb5\x89\x57\x45\x52\xa9\x16\xdd\x3e\x95\x4a\xe3\x16\xb5\xbf\xf9\x98\x38\x6a\x6f\x5d\xa3\x18\x8a\xb5\xa9\x95\xb6\x41\xd4\x6b\x6a\x0e\x16\x1e\x4c\xab\x8f\x98\x36\x8c\x6e\x23\xc3\x0e\xa1\xee\x11\xc6\x0d\xd9\xdf\xed\x1d\xf9\x6a\x93\x8e\xbb\xdf\xd2\x06\x7b\x2b\x3b\xf3\xb2\x34\x6c\xa6\xc7\x6e\xad\x9b\x46\x31\x2a\x16\x2f\xc3\xfc\x48\xe2\x22\xf2\xf8\xe6\xa4\xfe\x65\x4c\x11\x92\x1d\x5f\xc8\xc1\x07\x5e\x49\x6a\x77\x1a\xe1\x0d\xb5\x65\x7d\x01\x6e\x0a\x6e\xa4\x1f\xb8\x3c\x5c\xb3\xc2\x6a\xe3\xb2\x8a\x74\x03\x2f\xc3\x15\xe9\x32\x7b\x1c\x3c\x22\x21\x4b\xeb\x5f\xe6\xd7\x61\xe3\x7b\xaf\x6e\xf5\x84\x32\x18\x6f\xc1\x18\x37\x0d\xef\xe7\x30\xbe\xed\x7a\xe9\xe9\xe6\x7b\xcb\xd3\xd4\x9c\x31\x94\x39\xb1\xda\x25\x72\x3f\x59\xde\xa0\x79\x7e\xc2\xfd\xd6\x12\xc6\x93\xac\x32\x46\x31\xef\xad\xdc\x5c\xa3\xb1\xef\xd1\xad\x19\x7b\xf2\x74\xbe\xeb\x07\xc2\xa8\x40\x17\x8a\xdd\xdf\x64\x4f\xb5\x74\x91\x33\x5e\x36\xf9\x4e\xb2\xc9\x8c\xd5\x4a\x56\x9b\xb7\xca\xf2\x12\x2f\xb2\x34\xb6\xe1\xba\xe6\x06\x5c\x59\x0e\x42\xa9\x5b\x03\x56\x41\x81\x60\xd1\xf8\x4b\x6f\x1d\xd4\xcf\x6e\x8d\x46\xa1\x63\x6c\x4b\x61\x65\x52\x69\xb5\x6b\xa1\xff\x9a\x26\x71\xa3\x69\x2c\xae\xdb\x20\x83\xbb\xb9\xe3\xb8\xbf\xd1\x74\x4f\x06\x1a\xbc\x6c\x83\xa5\x92\xcc\x87\xec\xf7\x74\x3f\x45\xfe\x04\xe1\x35\xde\xb3\x5d\xd3\x7e\x4a\xc1\x6b\xbc\x07\x47\x33\xd7\x32\x85\x66\x94\x4e\x46\x35\x49\x83\x96\x26\x7e\x64\x92\x24\xea\x69\x86\x58\xfb\xa4\xed\x62\x21\x67\xb2\xac\x0b\x8a\x71\xed\x96\x63\x47\xbf\xb4\xe9\x32\x5d\x1f\x4c\x7a\xb2\xb8\x51\x9d\x5e\x3f\x30\x0b\xd1\xb0\x94\xb2\x2d\x99\xfe\xc2\x3f\x7c\x1c\x33\xbe\x0f\xe1\x81\xcc\xeb\x7a\x84\x92\x37\x68\x0c\xad\x70\x59\xcb\xa1\xa0\x90\x36\xe1\x96\x0a\x5e\x0e\x4e\x00\xab\x77\xb2\x74\x0e\x1d\xec\x88\x92\xe2\x11\xf0\x08\x53\xae\x2e\x8f\xcc\x75\x9a\x32\x07\x48\x57\x09\x5c\xb1\x03\xc4\x53\xa2\xe8\xac\x43\xf7\xe4\xec\xa6\x14\xbc\x2d\x14\xd5\x6c\xe6\x9e\x6a\x67\xfd\x9b\x41\xef\xa6\xc1\x69\x9b\x18\xe8\x7a\x46\x5f\x47\x86\x93\xca\xab\x77\xd1\x60\x90\x31\x28\x0e\x8a\x1f\xa8\xfb\x3b\xfb\xa5\x0d\x35\xce\x13\xe6\x38\x4d\x52\x72\x77\x66\x1c\xbd\x50\x9e\x55\xe4\x3e\x24\xfa\x2b\xc2\x1b\xd3\x72\x29\x51\x2f\x5e\xe0\xc7\xe7\x96\x28\x25\x52\x92\xf1\xf3\x4b\xec\x5d\x57\x7c\x1b\x1f\x53\x7e\x53\xd4\x21\x1a\x42\x5d\x7c\x98\x33\x7d\xb5\x38\x57\x4d\x86\x66\xbb\xca\x7c\x73\x4c\xc2\xa8\xfe\x9e\x46\x83\xee\x3d\x62\xa0\xa0\x63\x3d\x36\xb9\x56\xe3\x31\x16\xb7\x36\xad\xc6\x3f\x22\xef\xe2\xd9\x94\x7a\xa9\xc6\x9f\xaf\x4b\x38\x99\x42\xde\x7e\xfc\xb5\xe7\x50\x0c\xc1\x60\xaf\x85\xef\xbd\x7f\x45\xe9\x5e\xdb\x16\x9c\xcd\x8f\x14\x3b\x51\xf4\xce\x06\xd7\xbc\xbd\x80\xbf\x69\xb5\x37\x08\x5d\x41\xed\x6a\xa0\x9d\xe9\x1e\x5f\x17\xe4\x50\xad\xd5\x3e\x11\xb8\xb5\x07\x41\x54\xb2\xe3\xa4\xf1\xfc\xe9\x69\x5d\x27\xdc\xe2\x83\x59\x4f\x0f\x72\x9f\xe1\x3a\x5c\xdd\x09\x91\x38\x58\xc9\x20\xe3\x73\xf1\xf2\x93\xb9\xde\x52\xb2\x37\xdd\xbf\x5d\xc1\x11\x4f\xea\x78\x3c\x6d\xfe\xc9\x71\x1f\x9c\x4c\xc9\x30\x93\x43\x00\xab\xd0\xbe\x56\xc6\xba\x18\x1e\xa3\x56\xdc\xaa\xf4\x98\xe5\x31\x9f\x3e\x2d\x8d\xfe\x1c\xeb\x0f\x87\xe3\x92\xfd\x41\xed\x67\xcd\x60\xb9\x6e\x1c\x27\xb7\x53\xf7\x74\xd6\x15\x5c\x32\xbc\xcf\x49\xf2\xac\xb3\x80\x71\x2a\x54\x35\x3e\xe0\xff\x28\xcb\x0d\x3c\x10\x1a\xa2\x4f\x9b\x98\x2a\x77\x0d\x4a\x7b\xe4\xe1\x30\x90\xc7\x6d\xe9\xfc\x69\x79\x63\x0d\xef\xd1\xba\x04\x3d\xc4\xa9\x8f\xf4\x8e\x86\x0e\x93\x7e\xfc\xf7\x0e\xf5\x43\x72\xbe\x3e\x5f\x3f\x5b\x7f\xf4\x9b\xbc\x9b\xfd\xa7\x19\x77\x92\xa1\x36\xa5\xd2\x78\x12\x5b\x41\xcb\xdb\x42\xc9\xd3\x98\x5a\xd5\xb6\xa8\x4f\xd3\xd3\xff\x30\xe1\x14\xae\xfe\x20\x3a\x89\x2b\x86\xbc\x93\x78\x86\xbf\x3e\x98\xf2\x65\x69\xb8\x6b\xca\xd2\xf0\x1b\x96\xff\x07\x00\x00\xff\xff\x87\x1d\x5d\xd8\xd4\x22\x00\x00")
+var _staticIndexHtml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd5\x1b\x6b\x6f\xdc\xb8\xf1\x7b\x7e\x05\x4f\x87\x0d\x6c\x20\x5a\x39\x67\xe0\x5a\xd8\xbb\xdb\xa6\xb1\xd3\x18\x48\x9c\xc0\x76\x5b\x14\x28\xb0\xa0\x24\xee\x8a\xb6\x44\xea\x48\xca\x6b\xb7\xe8\x7f\xef\x90\x14\xf5\x5a\x69\xad\x75\xdc\x34\x0d\xee\x6c\x89\x1c\xce\x9b\xc3\x99\xa1\x3c\xfb\x29\xe6\x91\x7a\xcc\x09\x4a\x54\x96\x2e\x5e\xcd\xf4\x2f\x94\x62\xb6\x9e\x7b\x84\x79\x7a\x80\xe0\x78\xf1\x0a\xc1\xbf\x59\x46\x14\x46\x51\x82\x85\x24\x6a\xee\x15\x6a\xe5\xff\xde\x6b\x4e\x31\x9c\x91\xb9\x77\x4f\xc9\x26\xe7\x42\x79\x28\xe2\x4c\x11\x06\xa0\x1b\x1a\xab\x64\x1e\x93\x7b\x1a\x11\xdf\xbc\xbc\x41\x94\x51\x45\x71\xea\xcb\x08\xa7\x64\xfe\xf6\x0d\x92\x89\xa0\xec\xce\x57\xdc\x5f\x51\x35\x67\xbc\x07\x75\x4c\x64\x24\x68\xae\x28\x67\x0d\xec\x7f\xa6\x4a\xf0\xf0\x04\x7d\x2d\x94\xa2\x6c\x8d\x54\x42\xd0\x97\x9c\x30\x74\xcd\x0b\x11\x11\xa0\x84\xbe\x5c\x5f\x5c\xde\xf4\x20\xc4\x85\x4a\xb8\x68\xe0\xfa\x4c\x41\x3e\x92\xa2\x8f\x84\x09\x7a\x27\x01\xc9\xc1\x1f\x33\x18\x73\xaf\x87\x80\xc4\x62\x51\x54\xa5\x64\x61\x69\xcf\x02\xfb\x56\x4e\xa5\x20\x07\x4a\x04\x59\xcd\xbd\x40\xaa\xc7\x94\xc8\x84\x10\x25\x83\x90\x73\x25\x95\xc0\xf9\x34\x92\xd2\x43\x82\xa4\x73\xaf\x9e\x77\xec\x0d\xad\xe6\x20\x12\x05\x46\x69\xf4\xac\xe5\x09\x5d\x27\x29\xfc\xaf\x9e\xb5\x1a\xe7\x79\x4a\x23\xac\x35\x3f\xb4\x7e\x16\x58\x57\x79\x35\x0b\x79\xfc\xa8\x7f\x33\x7c\x8f\xa2\x14\x4b\x39\xf7\xe0\x31\xc4\x02\xd9\x5f\x3e\x79\xc8\x31\x8b\xfd\x2c\x76\x03\x86\x31\x14\xae\xed\x83\x63\x26\xa6\xd5\x7a\x6d\x20\x4c\x19\x11\xe5\x9c\x99\xc7\x6d\xec\x7e\x28\x00\xab\xe7\xd8\x6f\x40\x1a\x68\x9a\xad\x91\x14\x11\xcc\xd0\x0c\xaf\x89\x0c\xd6\x3c\x4f\x88\x58\x6a\xae\xa7\x39\x5b\x7b\xc8\xba\xa9\x77\x7c\x04\x38\x88\x66\x64\xee\xfd\x02\xcf\x25\x91\xd8\xa7\x0c\xd4\x43\xfc\x30\xe5\xd1\x9d\x87\x70\x0a\xf3\x1d\x22\xce\x1d\x70\x83\xcb\x10\xdc\x92\xb3\x0e\xab\x8a\xaf\xd7\x29\x48\x83\xf4\xde\x9b\x7b\x16\xc6\x43\x31\x56\xb8\x9c\xd3\x32\xa7\x29\xce\x25\x01\x52\x82\xe2\x52\x69\x24\x9e\x7b\x2b\x9c\xc2\x68\x8b\xb0\xfe\x67\xa0\x52\x1c\x6a\xcb\xdc\x18\x1c\x5a\xbd\x74\x6d\xac\xd6\xd5\x86\x04\x64\xfd\x3c\xf9\xda\xc9\xbc\xc5\x2c\xd0\x20\x0d\x39\x02\xcb\x64\x69\x9b\x00\x8c\xa3\x6d\x0e\x6b\xb5\xa9\x33\x30\x0e\x12\x5c\xb3\xad\x1f\xbd\x21\xbb\xcd\x42\x11\x34\xac\x4b\x63\xed\x44\x58\xc9\x65\xaf\x81\x1b\x0e\x90\x0b\xbe\x16\x44\x7b\x9e\x71\xba\xb9\x67\x2d\x74\x82\x8e\x8f\xf2\x87\xd3\xae\x74\x3d\x0b\x7d\xed\x7f\xcd\x17\x1f\xb6\x22\xcd\x49\xdc\x1e\xc4\x0c\xbc\x43\x11\x70\x23\x2b\x8d\x9b\x84\x39\xcf\xb0\xeb\x06\x96\x7a\x64\xcb\x06\x8e\x3b\xe3\x4a\x27\xe8\xed\xd1\xd1\xe4\xb4\xb4\xdf\x3d\x4e\x0b\xc2\xf8\x66\xee\xc1\x68\x73\x2c\xa3\x6c\xee\xb5\x47\xf0\x83\x85\x5a\x5c\xd8\x28\x49\xff\x09\x81\x6d\x3a\x9d\xb6\xa5\xb4\x36\x18\x7a\xad\x34\xdd\xd5\x88\xe0\x9b\x1d\xfa\x02\xaf\xf3\x65\xd6\x01\xd8\x02\xc2\x22\x46\x8a\x3c\x28\x3f\x82\xb0\x49\x4a\xd5\xe8\xd1\xe5\x8a\xb2\x18\x98\x95\x3d\x18\xfa\xb0\xf8\x3a\x58\x0c\xc0\x1a\xf8\xe4\xb8\x05\x6e\x02\x6d\x0f\xb9\xa5\x51\x9c\xb7\x38\x82\x30\x74\xbc\x03\x5d\xde\xc6\x06\x22\xf4\x21\xd3\x07\x8d\xb7\xf8\x50\xbe\xce\x82\x7c\x40\x98\xb6\xca\x77\x0c\xf7\x0d\xbd\xa8\xd2\x21\x0e\x7f\x37\x8d\x03\xad\x17\x52\xb7\xc6\xe4\x74\x0d\xcf\x3f\xbe\xa2\x23\x9e\x65\x54\x7d\x2f\x55\x97\xd4\x5e\x44\xd9\x0e\x97\x55\xf7\x7b\xfb\xf6\xe3\x2b\x5c\x90\x9c\x4b\xaa\xb8\xa0\xdf\xcd\xc1\x9b\x24\x5f\x44\xf5\x2d\x84\x56\xff\x57\x8d\xa1\x1f\xdf\x08\x0a\x8b\x35\xf9\x6e\x5e\x5f\x52\x7b\x11\xd5\x3b\x5c\x56\xeb\x37\xf6\xed\xc7\x57\x78\x5c\x88\xbe\xac\xed\xbf\xa5\x71\x47\xae\x52\xf9\xd1\x89\xf9\xef\x5b\x34\x5f\xe1\xb4\xaa\x3f\x2b\x5f\x5f\x5e\xf7\x8d\xd7\xf2\xb1\x91\x69\xda\x47\x49\x22\x4d\xdb\xe6\x6f\x90\xfb\xf7\x25\x29\xb3\xae\xa8\xee\xf4\xef\x54\x11\x2c\x2f\x94\x93\x7b\xc5\x45\xe6\xeb\xcc\x15\x72\x45\xd4\x7c\x01\xe3\xa3\x55\xca\xb1\xf2\x85\x29\x68\xca\x34\xdf\xaa\x28\x4f\x71\x44\x12\x9e\xc6\x44\xcc\xbd\x6b\x82\x45\x94\x40\x6a\xe7\xf5\x69\x45\x33\x5c\x25\x25\xd2\x80\x36\x59\x36\xe6\xa9\x5f\x15\x0e\x21\xe7\x2f\x79\xb3\x2f\xe6\xa7\xe6\xc6\x3e\x24\xfc\x9e\x08\x37\x68\xd3\x5f\x6b\x32\x33\x34\x94\xbb\xcd\x54\xdd\x04\xa8\xc7\x44\x8f\xb1\x54\x82\x64\xc4\x73\x5b\xbc\x78\xcd\xdd\x80\x23\xeb\xcf\xef\x22\xeb\x05\x2a\xd9\x6b\x79\x8e\x15\x48\xfe\x15\x7e\xee\xbd\xd4\x1e\x7c\xee\xc8\xdb\x7b\x79\x15\xbc\x1f\x1b\x51\xfb\x71\x1b\x0d\x8c\x88\xad\x91\x3e\xbd\xd9\xea\xb8\x03\xd8\x1e\x84\x01\x6d\x0f\xe7\xd4\xa5\xfb\x0e\x7a\x33\x94\x23\x3c\xbd\xd7\xa6\x2c\xab\x8f\x98\x4a\x70\xb2\xc7\x13\xc4\x38\x23\xa7\x1d\x27\xbf\x2a\xa1\xdb\x9b\xbb\xde\xcc\x26\x22\x65\x85\x72\xae\xe1\xb0\x97\x1b\xd9\x6c\xe0\x7d\x7c\xae\xc7\xcf\x2a\x86\x5f\xc6\xcf\x16\xd7\x74\xcd\xb0\x2a\x04\xf9\x5f\xf8\xd5\x27\x2c\x15\xba\x26\x84\xfd\x5f\xbb\xd6\x2c\xd0\x75\xfb\x62\xf6\x93\xef\xa3\x60\x5a\x55\xe3\xc8\xf7\x75\x79\xbf\xe2\x1c\x0e\xa8\x1d\x6d\x99\xe6\x39\x86\x1a\x1e\xd4\xea\xd6\xd8\xc6\x4c\xa2\x54\x2e\x4f\x82\x60\x4d\x55\x52\x84\x40\x2a\x0b\x9a\x1d\x36\x3d\x2e\x78\x08\x21\xd3\x1c\xd6\x73\x6f\x19\xa6\x98\xdd\x79\x8b\xba\xb7\x82\xa8\x44\x58\x97\xed\xb7\xc0\x3d\x0a\x1f\x01\xf7\x96\xda\x6b\x5a\x40\xaa\x89\x5f\x13\xdc\x46\xbe\xd5\xf7\x33\x74\x5e\x67\x34\x8e\xb9\x3a\xdd\x4d\xe0\x69\x61\x02\x2a\x65\x41\x64\xc0\xc8\x66\x9b\xb4\xb6\xbc\x50\x08\xc3\x86\xd6\x50\x55\xf3\xa8\x6a\xb1\x38\xe5\xbf\x9a\xd9\xee\x67\xe3\x30\x09\x14\xc9\x60\xa7\xab\xf2\x30\x77\x6f\x2e\x90\xbb\xa6\x8b\x8a\xfb\x42\x71\x6d\x9a\x09\xa2\x2b\x74\x60\x43\x33\x9a\xcf\x91\xf7\x99\xc7\x74\xf5\xe8\x1d\xa2\x7f\xa1\x49\x03\xae\xd9\x34\x0a\x71\xbc\x26\xc8\xfc\xf4\x73\x41\x33\xac\xbd\xf8\xf3\x97\xb3\x8b\x0f\x7f\xdf\x6a\x1d\x4d\xd0\xbf\x11\x49\x25\xe9\x92\xb9\x60\x92\x08\x35\x9a\x8c\x2c\xa2\x48\xb7\x80\x16\xef\xaf\xce\xdf\xdd\x9c\x8f\x26\x73\x46\x52\x02\x2a\x1a\x4b\x26\xc6\x6c\xad\xfb\x50\x67\xe7\x9f\xce\x07\xa8\x4c\xb6\x94\xa7\x12\x2a\xa7\x19\x8f\x49\x3a\xa5\xf2\x92\x6c\x0e\x0e\xc7\x92\xdb\x60\xc1\xb4\xad\x90\xc9\xca\xe6\xde\x25\x57\x90\x4b\x14\x2c\xd6\x9e\xad\x7b\xd9\x21\x96\x44\x37\x1e\x91\x04\xe1\x8d\xe5\x2e\xcf\xff\xb6\x3f\x5b\x3c\x8a\x0a\x21\x08\x03\x15\x1e\x1c\x4e\x53\xc2\xd6\x10\x99\x16\xe8\xed\x68\xed\x13\xd8\xed\xb1\x36\xb3\xe3\xf4\x4b\x8d\x11\xf1\x15\xd2\xb4\x80\xc7\x48\xe8\x76\xf0\x6b\x45\x33\x22\x4f\x67\x13\x1f\x3d\xc5\xc3\x64\xb1\x4b\x18\x08\x58\x71\xaf\x1b\xdb\xd0\x3d\x8b\x00\x71\x27\xe6\xb9\x48\xf3\x33\x4c\x4f\xe6\x96\xbe\xce\xce\xb0\x82\x90\xa4\x1b\x0d\x3a\xde\x1f\x1c\x1a\xc2\xcd\x4e\x6d\x60\x70\x0d\x12\x74\x01\xdf\x92\x2c\x15\x6c\xb3\x8a\xbf\x88\xd4\xaa\xb1\xa2\xad\x05\xaf\xe6\x60\xa2\xec\x98\x33\xae\xdb\xf8\x10\x23\x19\x07\x30\x22\x48\x4f\x0f\xb1\x1b\x20\x2a\x19\xac\x0e\x65\x02\xe1\xc2\xa2\xfe\x88\x65\x2d\x47\xed\xfe\x86\x91\xc9\x7c\x1b\xf1\x13\x48\xac\xd6\x6b\x35\x24\xbd\x6a\x68\x9e\x5a\x2d\x71\xeb\x23\xec\x29\x91\x7b\x44\xf4\x5b\xdc\xd6\xa8\xbe\x6c\xf4\xd2\xc9\x22\x68\x53\xb8\xc4\x19\x71\x72\x1b\x46\xc1\x85\x4c\x70\xdc\x2b\x4c\x76\xf2\x10\x10\x74\x61\xed\x66\x6e\x83\xaa\xac\xe2\xac\xbe\x76\x32\xf1\xe4\xf2\x9d\x87\xfe\x80\xb4\x23\xf5\x82\x9c\xec\x44\x30\x59\x8c\xf2\x68\xcd\x87\x73\xd5\x96\x51\xc6\xf9\xa6\x8f\x74\x5a\xa2\xb3\x12\x6b\xe4\xa9\x2c\x42\x48\xc4\x0e\x8e\xde\xa0\xdf\x1d\x8e\xc0\xd7\x32\x72\x4b\xf3\x4f\x19\x44\x23\x7c\x96\x31\xca\x33\x6b\x09\x0e\x8a\xd3\x9e\x2b\x20\x33\xee\xeb\xb4\xa7\x7d\x49\x90\xfc\xda\x86\xb0\xc5\xad\x69\x28\xa2\xca\x02\xe8\x33\x56\x51\x72\x82\x9c\x5a\x07\x4c\x63\xab\x46\x67\xbf\xde\xe5\x2d\x3f\x7d\xca\xd0\xc9\xaf\xdb\x17\x41\xed\x1b\x1f\xa7\xf3\x94\xeb\x8b\x1e\x73\xff\x03\xd9\x7b\x46\x2b\x79\xbc\xd6\xbd\xce\x7b\x03\xd7\x77\x97\x63\xa0\x12\xc8\x57\x08\x03\xa5\x0a\x5d\xc6\xbb\x18\x3c\xe6\x26\xa7\x5f\xdb\x9d\xbe\x42\xf3\x50\xa1\xf2\x86\x48\x75\x45\xb4\xed\xe2\xed\xd3\xae\x81\x0a\xa7\x44\xe7\x37\xfa\x67\x7d\xd8\xd9\xdb\x15\x33\x08\x1e\x06\xae\xc9\xd9\x7a\x01\x47\x1f\x8d\xc8\x09\x30\x6c\xdf\xd1\x8d\x3e\x52\x74\x9b\x18\xa5\x9c\xdf\x49\xa4\x38\x0a\xa1\xc0\x00\xc2\xfa\xa2\x57\x58\xe2\xd3\xc1\x2b\x90\xee\x99\xd8\x60\x2a\x54\xcc\x5f\x0b\x5e\xe4\xa8\x7a\xea\x16\xed\x1d\x2d\xf7\x9a\xaf\x51\xa0\x2f\xf5\xc5\xf7\x52\xe0\x8d\xd7\xa0\x61\xb0\xd7\x27\xe7\xe2\x0a\x6f\xda\xea\xdf\x13\x7d\x42\x1e\xe2\x22\xcb\x77\x91\xf8\x48\x1e\x90\x86\xd9\xa6\xd3\x55\x4f\xab\x76\x2b\xc9\xf8\xfa\x76\xdc\x37\x33\x5d\x05\x58\xe3\xf3\xf0\x76\x7a\x4d\xee\x89\xa0\xea\xb1\x63\xf4\x5d\xf5\xda\xc2\xad\x39\x19\x2a\x93\x6c\xec\x6d\xa2\x6f\xc5\xca\x1d\xc5\x50\xc7\xcc\x3b\xd9\xd0\xf1\x74\x17\x0b\x36\x2c\x96\xfe\xd7\x1f\xf9\x2a\xf7\x0c\xfa\xe1\xaa\x50\x58\x81\xf5\xc6\xf2\x9e\x56\xd7\x08\x59\x87\xe4\x7a\x67\xbe\x64\x78\x42\xb9\xf6\x2c\xb0\xa0\x63\x95\x3b\x44\xf0\x33\x24\xa3\x78\x4d\x86\x29\xd6\x4d\x32\xa6\x7c\xaa\x70\x4a\xa3\x46\x2e\x03\x11\x8a\x45\x7a\xf7\x5a\x9e\x4a\x6c\x65\x32\x33\xca\xe6\xcf\x4b\x6f\x77\xca\xd4\x48\x68\x9f\xd0\xe4\x98\x9c\xf6\x1b\x94\xfb\x81\x0a\xa9\x20\x97\x26\xec\x69\x5f\x6d\x73\xb3\xd2\x2b\xf5\xb9\x0f\xbc\xf4\x67\x8a\x66\x15\xda\xb1\x0c\x32\xb3\x03\xcf\x2e\x3b\xc3\xa6\x6e\xfa\x46\x69\x4c\x87\xe4\x39\xc2\xa4\xf8\x39\xb2\x34\x56\x3d\x5b\x94\xbe\x90\xd2\x8c\x7f\xba\xc8\xd9\xc7\xb3\x3e\x41\xfd\x36\xc6\xa5\xaa\x32\x45\x2f\xb8\x2c\xb2\x90\x88\x83\x6f\xd6\xbf\x4d\x5e\xc6\xe8\xbe\x16\xae\x2f\x47\x7c\x72\x3b\xea\xe5\xe7\xba\x01\x9e\xef\x75\x34\x94\x4b\x46\x9c\x0c\x25\xe4\x54\xf1\x0f\xf4\x01\xd2\x8e\x5f\xbe\xd1\xa0\xbb\x8c\xfc\xb5\x48\xd3\x2b\xf2\x5b\x01\xc9\x86\x44\xaf\x5f\xa3\xee\x58\x1d\x63\x8e\xf6\x11\xf6\x8a\xe8\x83\x7c\x57\x7c\xe9\xbf\x1e\x99\xa0\xe5\x94\xe0\x28\xd9\xe2\xed\x0d\x5a\x41\x28\x35\x29\xe7\x41\x5e\x8f\xf7\xf0\xd4\x11\xb2\x01\x3c\xad\x0a\xd8\xfe\x05\xcd\x32\xaf\xb3\x6c\xb8\xd0\xeb\xad\xf4\x7e\xee\xa2\xb0\x4e\xde\xad\xc5\xb7\xec\x54\x15\xb6\xbd\x20\xc3\x58\x87\x31\xee\x98\x3b\x3c\xed\x9b\x7d\x81\xc0\x71\x45\x56\x95\x2f\xe9\xe7\x67\xfb\xd0\x4a\x8e\xde\xcf\x86\xce\x2d\xa7\xec\xc0\x7b\x83\xbc\xc3\xbd\x37\xf6\xf8\xdc\xea\xe2\x6c\x6f\xaf\xae\x38\xbd\x88\x77\x64\x46\xcd\x04\xb9\x99\x12\xd3\x78\x19\xa5\x34\x0f\x39\x16\xf1\x56\x4a\xcc\x0b\x65\x3e\xd7\xab\x52\x63\x9b\x28\x67\xde\xe0\x85\xa6\xfe\x67\x2a\xb0\x0a\xa9\xb9\xd4\xb4\x6e\x6f\x18\xd4\xa5\x4a\xa3\x3d\xc6\x29\xe2\xb4\x86\xae\x3e\x9a\xeb\x4f\xf1\xc7\xf8\x50\xab\x3d\xdf\x71\x1e\x53\x6c\x3e\x28\xe7\x3f\xe5\xeb\xb0\x0b\xcd\x72\x28\x5c\xb5\xba\x4c\x2d\x57\xd5\xd7\x91\x5d\xe7\x55\x0e\xe2\x10\x59\x1f\xf9\x07\x2b\x7d\x04\x56\xef\x28\xa5\x92\xa1\xaf\xcb\xb6\x2e\xb4\x1d\xf5\x94\x2c\x65\x4e\x19\x04\x88\xde\x8f\xfd\xaa\x6f\x34\x4b\x3c\x25\xac\xd7\xfe\x66\xb3\x1c\x9d\xae\xe9\xaa\xfc\x02\xf3\x13\xc7\x5a\x2c\x5b\x25\x96\xdf\xf1\x4a\x7d\x9f\x3a\x40\xdc\x0b\x3a\x34\xf3\xc5\x10\x8a\xd6\x5d\x75\xb7\x78\x72\x9f\x2f\x36\x28\xb8\xa5\xc3\xf2\x75\x2d\xd2\x58\xe4\x75\x55\x3e\xb4\xc0\x95\x80\x5b\x26\xda\xba\x07\xef\xaf\xe9\xed\x75\x82\x37\xd0\xf1\xad\xef\x6e\x50\x23\x71\xb7\xcf\x1b\xf3\xc9\xa5\xfb\x46\xb7\x67\x27\x98\x99\xb0\x48\xc3\x6a\x27\xa0\x1b\x9a\x9f\xa0\x3f\x09\xbe\x81\xf0\xed\xae\x97\x75\x63\xbb\x90\xee\x53\x6d\x83\xa7\x77\x4f\xb6\x70\x63\x01\x48\xfc\x94\xac\x54\x8d\x1c\xb3\x18\xf5\xb0\x61\x41\xcb\x22\xbe\x82\xd5\x83\xe8\x8e\x3c\xca\xe9\x56\x43\x04\x1b\x1d\xeb\x73\xd9\xd7\x2a\xf6\x1a\xa7\x9d\xae\xdb\xf6\x3e\xe5\xba\xa1\xc8\xdd\x8f\x34\xa5\xb4\x0d\x90\xb2\xd0\x5f\xfc\x15\x68\x5b\xff\x83\x10\x37\xb3\x41\xc4\x95\x4a\x80\xf9\x23\x97\x4a\x97\x95\x65\x2e\x58\x06\x19\xbc\x15\x26\x3a\xdd\xe8\x5e\x09\xcb\x56\xe1\x7e\x7d\xea\x31\x52\xd6\x3d\x88\x27\xe4\xb4\x1c\x8c\x92\x14\x0f\x5d\x0a\x94\x77\x63\x75\x9f\xb1\xeb\xe7\x9a\xdd\x10\x1c\x8e\x3c\xcc\x3d\xff\xad\x6b\x38\xc5\x14\xa7\x7c\xdd\x6e\xac\xec\x6e\x38\xda\x15\xc8\xbe\xa4\x55\xe7\x2a\xe6\x51\x91\xc1\xbe\x1d\xf8\x5c\xd9\x82\x97\x7b\x5b\x3b\x61\x77\x6f\xba\x4f\x55\x5c\x7f\xd4\x46\xb9\x5b\x7c\x8f\xed\x80\x0c\x6e\x21\x93\x11\x8f\xfe\xf1\xf4\x78\xfa\x76\x7a\x6b\xe2\x83\x93\x76\x78\x51\x01\x02\x0b\x19\x81\xd1\x46\x2f\x09\x71\x74\x17\x72\x36\x7e\x41\xce\xf3\x1c\x42\xf0\x68\xfc\xd5\x5f\x3d\x8c\x5d\x51\x1d\xa8\xa3\x57\x94\x31\x71\x34\x7c\xf3\xcf\x19\x3a\x6b\x02\x7b\x5f\x3e\x0b\xec\x1f\xc4\xfc\x07\x19\x55\xa4\xc2\x21\x33\x00\x00")
 
 func staticIndexHtmlBytes() ([]byte, error) {
 	return bindataRead(
@@ -276,12 +276,12 @@ func staticIndexHtml() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "static/index.html", size: 8916, mode: os.FileMode(420), modTime: time.Unix(1585084588, 0)}
+	info := bindataFileInfo{name: "static/index.html", size: 13089, mode: os.FileMode(420), modTime: time.Unix(1792320220, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
 
-var _staticJavascriptsApplicationJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x5b\xef\x72\xdb\x38\x92\xff\x9e\xa7\xc0\x22\x9e\x33\x19\x53\x94\xec\xbb\xcc\xce\xd0\x51\x72\x9e\xfc\xf5\xd5\x4c\x26\x95\x64\xee\xaa\xce\xf2\xfa\x20\xb2\x25\x21\xa6\x40\x15\x00\x59\x72\x62\x5d\xed\xd3\xec\x83\xcd\x93\x5c\x01\x04\x48\x80\xa4\x64\x39\xf7\x61\xa7\xb6\x1c\x11\xe8\xfe\x75\xa3\x01\xf4\x3f\x72\x6f\x08\x47\x9f\x24\x91\x02\x0d\xd1\x2f\x24\xbd\x1e\x17\x0c\xe2\xdf\x8a\x0c\xf2\x18\xd6\x12\x58\x16\x7c\x7b\x84\xd0\x92\xe7\x09\xc2\x7d\xa1\x08\x71\xf4\x08\xa1\x0c\x26\x64\x99\x4b\x91\x20\x35\x8d\x10\x56\x18\x4b\x81\x13\x64\xfe\xc3\x94\x51\x49\x49\x4e\xbf\x52\x36\xd5\x2c\x25\x11\x97\x90\x9d\x49\x43\xc7\x96\x79\x6e\xa6\xde\x50\x46\xc5\xac\x9e\x73\xa6\x3e\xf0\x62\xca\x41\x54\xe0\x03\x33\xfe\x99\xf0\x29\xc8\x5a\xa6\x1d\xff\x08\x8b\x42\x50\x59\x70\x0a\x7a\xd2\x8e\xbf\x2c\xe6\x73\xda\x41\xff\x86\xe6\xe0\x68\xee\x8c\xb3\x8c\xb2\xa9\x2f\x77\xa3\xfe\x50\x61\xd5\x4d\xd0\x64\xc9\x52\x49\x0b\x16\x84\xc6\x14\x1c\xe4\x92\x33\x24\x67\x54\xc4\x53\x90\x81\x35\x4d\x88\x86\xc3\x21\xc2\x13\xc3\x89\x4f\x2d\x5a\xb6\xe4\x44\x21\x74\x60\xd1\x09\x0a\x3c\x20\x63\xbe\x12\x4b\xd9\xc8\x52\x56\x72\xf1\x60\x90\xe8\xff\x69\x01\x08\x6d\xf4\x5f\xb5\xcd\xc0\xb2\xd3\xea\x41\x28\x2c\x34\x44\xaf\x88\x84\x78\x41\xb8\x80\x6e\x41\xe1\xa9\xaf\x48\xbd\xf4\x20\xac\x65\x03\xcb\xb6\x61\x39\x1b\x6b\xc1\x36\x08\x72\x01\x5d\xcc\xac\x58\x05\x61\x53\xef\x39\xcd\x73\x2a\x10\x42\x43\x4d\xda\x2b\x75\x77\x96\x02\x69\xc1\x32\xa1\xe6\x7f\x23\x72\x16\x4f\xf2\xa2\xe0\x81\xe1\xea\xa3\xe3\xc1\x60\x10\xd6\xd4\xca\x68\x4a\x16\x1a\x22\x06\x2b\x2d\x36\xd0\x86\x2c\x49\xec\x74\x2c\x40\x7e\x2a\x81\x03\x23\xc0\x50\x18\x3b\x57\x84\xb2\x38\xff\xf4\xfb\x27\xc9\x29\x9b\x06\x61\x2c\x96\x63\x21\x79\x70\x7c\x1c\xa1\x9f\x42\xb3\xc5\x9b\xf0\xf4\xd1\x8a\xb2\xac\x58\xc5\xc2\x5c\x35\x25\x5a\x5f\xbb\xd3\x47\x8f\x94\x56\xe6\xac\xed\xbc\x84\x34\x3b\x93\x92\xd3\xf1\x52\x42\x82\xf0\x79\xa6\x6f\x95\x04\x21\xd5\x01\x3e\x67\x19\x4d\x89\x2c\xb8\x48\xd0\x05\x56\xa3\x38\x42\xf8\x4a\x2c\x20\x55\x3f\x26\x74\x2d\x97\x1c\xd4\xcf\x79\x91\x5e\xab\x7f\x85\x5c\x8e\xf5\x14\xb9\xd6\xe3\x19\xcc\x0b\x3d\x4e\xe6\x8b\x1c\xf0\xa5\x42\x17\xb3\x82\xcb\xf2\xde\xbc\x23\x62\xb6\xcf\x69\xaf\xa9\x71\x65\x8d\x41\x84\xfe\x1a\x56\xe7\x5d\x72\x3a\x9f\x43\x56\x12\xfe\x06\x42\x90\x29\x74\x20\xeb\xad\x2f\x67\xd1\xb0\x25\xc0\xf0\x29\x19\x8b\x9c\xca\x00\xf7\xd4\x7f\xaf\xdf\xbf\x42\x1f\xde\x7e\x40\x9f\xce\xdf\xbe\x3f\xfb\xfc\xc7\xc7\xd7\x7a\x14\x47\xe8\x24\x8c\x17\xc5\x22\xf0\xb7\xd0\xa0\xc7\x1c\x16\x39\x49\x21\xe8\xff\x6d\x24\x46\xe2\x49\x3f\x42\x18\x87\xf5\xa8\x1e\x3c\x28\x47\x6b\x0f\xf0\x19\x84\xfc\x08\x39\x91\x9d\x4e\x40\x29\xbf\x20\x72\xe6\x69\xae\xf6\xe9\x03\x91\xca\x30\xb2\xf8\xb5\x58\x01\x7f\x49\x04\x58\xa5\x26\x05\x47\x81\xe2\xa3\x68\x88\x06\xa7\x88\xa2\x67\x25\x6f\x7b\x8b\xe3\x1c\xd8\x54\xce\x4e\x11\x3d\x3a\xaa\x2f\xa1\xba\xa3\x4a\x66\x4c\x59\x06\xeb\xdf\x27\xc1\x16\xee\x0b\x7a\x19\xa2\xe7\xa8\x77\x5c\xb3\xd6\xfb\xc8\x97\x70\x6a\x06\x37\xce\x3d\x34\xd3\x13\x92\x0b\xa8\x36\x72\x42\x73\x78\x59\x30\x09\x4c\x8a\x3f\x54\x84\xd8\x76\x3a\x2e\x70\x7f\xa2\x9d\x6c\xe4\x58\xa3\x72\xd3\xb7\xbf\xaf\x18\x70\x1c\x76\x4f\xbe\x27\x73\xf0\xe7\xdc\x13\x16\x75\x9a\xf7\x32\xfe\x52\x50\x16\xe0\x3e\x0e\x3b\x95\x75\x34\x4d\x49\x9e\x8f\x49\x7a\x1d\x21\xe0\xbc\xe0\x56\xf1\x83\x98\x7c\x21\xeb\xc0\xda\x47\xc7\x3f\x2d\xa9\xb1\xe6\x20\x8c\x0c\x89\x58\xa6\x29\x08\x91\xa0\x0a\xd1\xba\x37\x85\x9b\x94\xff\x94\x16\x75\xfd\x82\x7b\xfb\xbd\x18\xfc\xb2\xc8\x73\xd0\x3a\x76\x04\xe2\x89\x0d\x4d\x4a\xc8\x5c\x39\x8a\xc4\x82\x18\x58\xe3\x6f\x26\x35\xb2\x72\x39\x56\x50\x60\x25\x6b\x1f\xf4\x9f\x14\x56\xae\x68\xf5\xec\x3b\x9e\x44\xb9\x0b\x22\xc5\x55\x5a\x30\x49\xa8\xda\x2e\x47\xb2\x9e\x52\xcf\x8b\x22\xcf\x29\x9b\x7e\xa6\xe9\x35\xf0\xa4\x8a\xe1\x36\xc0\x35\xc7\x0d\xf9\x39\x93\xc0\x6f\x48\x9e\xa0\xa7\x03\x1d\x63\xab\xd4\xa1\xcb\x2d\xe8\x5d\xc8\xa9\x90\xc0\x3e\x17\xe5\x11\xd7\x6a\x44\x08\xa7\x33\xc2\xa6\x60\x0f\x19\x07\x96\x01\x0f\x6b\x26\x1d\x36\x5e\x79\xba\xd8\xbb\x57\xcf\x7f\x28\x75\x0a\xea\x83\x53\xe2\xec\x0a\xce\x5a\xfe\x96\xc8\x68\x90\x8b\x85\x07\xec\xcd\x74\xab\xb4\xe9\x92\x31\x23\xe2\xa5\x5e\x64\x16\xd4\x69\x51\x53\xda\x72\x91\x11\x09\x76\x7a\x6f\xbc\x2a\xdd\xe9\xc6\x73\x8f\xce\x9e\x78\xea\xc6\x6f\x03\xcb\x61\x7f\x24\x9b\xb8\x75\x63\x99\xd9\xbd\xd1\xbc\xf4\xb0\x1b\xd2\x25\xd9\x1b\xd7\xa6\xa3\xdd\x90\x66\xd6\x45\xd3\xa7\xcb\x3d\x74\xdb\x4e\xbb\x77\xad\xd0\x10\x09\x90\xf6\xce\x04\x2d\x0e\x54\x5e\x47\x7d\x87\x4b\x25\x27\x20\xd3\x59\x25\x38\xf2\x30\x2d\x4e\x7d\xdc\x9d\xb3\xba\xeb\xcc\xfb\x3a\xfd\xa5\x95\x8d\xa6\x39\x10\x5e\x69\xd9\x66\xe9\xb4\xc3\xab\x86\xa3\xe8\x36\x87\x4f\xf5\x10\x7b\x94\x5b\x61\xf9\x83\xd0\x5a\xa4\x4a\x11\x2b\x0b\xec\xa7\x49\x13\xaf\x91\x2b\xfb\x7e\x6f\x3f\x23\xf9\x3c\x4d\x2b\xf9\x02\x3b\xd4\x3a\x08\xf0\xe3\x94\xf0\xec\xca\xe2\x5c\xdd\x90\x7c\xa9\xd2\x24\x09\x6b\xe9\x1e\xdd\xac\xd2\xba\x5e\xb9\xef\x39\xb6\xa4\x35\x42\x97\x33\x36\xb1\x29\x9f\x3e\x17\xef\x96\x73\x52\x59\xe0\x20\xc0\x92\xca\xbc\x12\x8b\xdf\x52\xc9\x8b\x71\x82\x30\x3a\x32\xfc\x35\xe5\xe3\x85\x91\x77\x35\x26\xdc\x72\x18\xa2\x38\x15\x22\xc0\x2b\x9a\xc9\x99\x75\xeb\xa5\xf6\x3a\xe0\xd7\x1e\x10\x1d\x21\xfc\x03\x6e\xda\x7f\x97\x5f\xee\x10\xcc\x61\x5e\xdc\xc0\xcb\x9c\x28\x99\x76\xae\x37\x26\xbc\x47\x18\x9d\xab\x44\x0f\x79\xa3\x42\x72\xba\x80\x0c\x37\xb4\xc4\xc7\x83\x41\xa5\x4b\x63\xe7\xac\x13\xdd\xb5\x73\x36\x64\x57\x3b\x37\xa3\x19\x04\xed\x0d\xb4\xd5\x95\x71\xda\x3a\xa5\x4c\x49\x0e\xb6\x14\x09\xe3\x09\xc9\xe0\x9c\x05\x78\x42\x84\xc4\xcd\x5d\xd6\x2e\x78\xb7\x1e\x39\xec\xab\x84\xf6\xf4\x0f\xd5\xc0\x38\xee\x5d\x3a\xa4\x25\xc9\x5e\x5a\x54\x51\xe2\xa1\x7a\xb8\xde\x7e\x97\x32\xdc\xa1\xdb\x4b\x23\x3f\xd2\x3c\x54\x2d\x13\x31\x76\x69\x24\x4b\x92\xbd\x94\xa9\xc2\xd3\xfe\x7a\x78\x77\x7b\xa7\x37\x28\x0f\xbb\x58\x51\x15\x69\x9a\x92\x6d\xff\xc3\xb2\xa5\x44\x40\xa3\x3f\x94\x38\xae\x5a\xfb\x16\x7c\xee\x4e\xdb\x94\x69\xcc\x81\x5c\x9f\x3a\x20\x53\x22\x67\xc0\xbb\x11\xde\xda\x39\xe4\x6e\xdc\x76\x2c\xc2\x48\x7e\xbb\x45\x9b\x33\x3b\xe7\x63\x6d\x83\xaa\x7a\x3c\x6d\xa4\x37\x6e\xfb\xa7\xc1\x6c\x9a\x6a\x6d\xa6\x3f\xd8\x35\x2b\x56\xac\x8b\xc7\x2b\xcf\x0c\xc7\x11\xc2\x28\x50\xae\x56\xf7\x62\xce\x59\xfb\x30\xb8\xb9\xa3\x72\x9d\x61\xd9\x8d\x6a\x75\x2a\x4c\x65\x50\x75\x2b\xd4\x73\xf0\x4d\xe5\xfc\xea\x0c\x36\x4b\x82\xb0\x59\xd0\xdc\x57\x58\x48\x32\x55\xe5\x5d\x82\xb0\x2c\x0b\x0a\xb8\x29\xcb\x33\xd3\x54\x4c\x73\x9a\x5e\x23\x99\xc5\x69\x91\xf7\x74\x39\x4d\xb0\x2a\x45\x66\xc5\xca\x48\xc0\x55\x4f\x4e\xc2\x7c\xa1\xaa\xf1\x04\x5d\xc5\xf6\x77\xa0\xb4\xb4\x0f\xd6\xb1\xaa\x7b\x22\xe7\x79\x10\x86\x3b\xb3\x7b\x6d\xb2\x03\x95\xe3\x29\x62\x53\x4a\x1b\x58\xc7\x9c\xc4\xb6\x64\x44\x18\xc6\x19\x91\x24\xc0\x56\x8e\x1b\xb0\xb6\x85\x26\xa7\x8b\xd0\xaa\x1a\x94\x70\x92\x65\x26\x20\xa9\x3a\xbe\xc7\x4b\x52\x1c\x76\x6c\xbe\xe2\xa9\xab\xdd\x82\xcf\x89\x94\x90\xd9\xa2\x78\xdb\xf5\x5d\xe4\x54\x0a\xa4\x9b\x6b\x5d\x6e\xdd\xb4\x2b\x4c\x8f\xa5\x8f\x9d\x46\x9a\x8a\x0f\x8c\xcc\x41\xb1\x96\x30\x6e\x8b\x45\x51\x64\x94\x43\xaa\x6a\x78\x0b\x0e\x79\x4e\x17\x82\x0a\xfa\x15\x02\xc3\x52\x15\xea\x11\xfa\x71\x10\xa1\x93\xa7\x8e\xa5\x1c\xfe\xe1\x10\x61\xdc\xee\x75\x3e\x13\x92\x17\x6c\xfa\x5c\x1d\xf6\xab\x18\x44\x4a\x16\x10\x58\xc5\xf4\xd1\x7e\xd6\xb7\x24\x1d\x26\xab\x58\x2a\x49\x9a\xa7\x8f\x35\xe7\x03\xb1\xb5\xdd\x9d\x15\x3a\x16\x17\x92\x47\x68\x4e\xd9\xaf\xba\x6d\x13\x21\xc8\xa6\x50\xfe\xb6\x4b\x12\x52\x25\xb2\xc6\x23\x0b\xc9\x1d\x2b\x08\xc9\x4d\xbf\x07\x3d\xab\x41\xd0\xdd\x1d\x72\x67\x86\x28\xa8\x51\xd1\x13\x74\x12\xb6\xac\x25\x24\x6f\xb5\x84\xb3\x29\x94\x34\x43\x74\xc6\x39\xb9\x75\x41\x8e\xd0\x71\x68\xf6\x27\x76\x37\x7e\x4e\x33\x43\x31\x74\x55\xe8\x21\x5f\x81\x53\xb7\x11\x26\x81\x33\x2d\x05\x6b\xc7\xa4\xe5\x1e\x21\x1c\xc6\xdf\xd4\x63\x8d\x78\x84\xf0\xc6\xa7\xc0\xa7\xbe\x87\xe3\x55\x63\x4e\x79\xa5\x8f\x30\x7d\xbd\x5e\x04\x46\x42\x18\x21\x7c\x70\xfc\xe7\xdf\xff\x71\x70\xe2\x86\xb1\xda\x5d\x38\x7b\x02\xd6\x3e\x10\x2f\xb8\xf6\x3b\xaf\x4a\xf7\xeb\xf5\x04\xe6\x84\x5f\x9f\x89\x4f\x90\x43\xaa\xaf\xa8\x63\x85\x22\x23\xb9\xe3\x1f\x8d\x84\xdf\xd4\x70\xd5\x37\x32\x0d\x12\xa7\x4b\x61\x9b\x42\x79\x82\xf0\x63\xe3\x29\xae\x34\x16\x8a\xf5\x3f\xbd\xb4\xec\x2e\x61\xa7\x57\x84\x6a\x69\xa6\xad\xe1\x64\xda\x3e\x0a\x0e\x4b\x98\xa0\xc5\xa8\xcb\xc0\x37\x4e\xfb\xca\xe9\x71\xf8\xcb\xdc\xe5\x0d\xd3\xbc\x10\x20\x64\x80\xe5\xb8\xc8\x6e\x71\xa8\x3b\x4c\x01\x96\x3c\x96\x64\x9c\x43\x4f\x18\x8c\x66\x3e\xdd\x9c\x3d\x7d\xb4\xcd\xcf\x75\x10\x76\xf5\xca\xee\x8b\x2d\x69\xd5\x3f\x4b\x90\x4d\xa9\xbf\xa7\xb9\x54\xe3\x44\x08\x93\x2c\xf3\xdb\x4b\x46\x1b\x77\x39\x15\x7b\xd9\x16\xb3\x6d\xa9\xa4\x4a\xd5\x23\x74\x15\x67\x30\x2e\x96\x2c\x35\xa1\xa4\x4c\xf8\x22\xf4\x74\x30\x08\xdb\x1b\x2b\xae\x04\x10\x9e\x2a\x3f\x5c\xb0\x00\x5f\xc3\xed\x72\xd1\x01\x52\x12\x59\x29\x11\x3a\xe9\x04\xab\x4e\x89\x82\x52\x37\x23\x1e\x8b\xf2\xc4\xe0\xc8\xb9\x1c\xea\x3e\xb8\xc5\x52\x56\xa4\xcb\xb9\x1a\xb3\x2a\x64\x2a\x1f\x89\x3a\xae\x93\x93\x08\x42\x7c\x0d\xb7\x2f\x8b\xcc\x9b\xd3\x19\xd2\xbf\xfe\x35\xa9\x06\x6c\x34\xb1\x2f\x40\x26\xce\x06\xeb\xab\x49\x8b\xa5\x30\xcb\xaa\xbb\x67\x8d\x34\xa8\x46\xfe\x79\x4f\x64\x06\x6b\xb9\x0f\x6a\x23\x29\xab\x7d\x51\x4d\xb2\xa9\x7e\x29\x7f\x6d\xa4\x58\xb7\xa8\x42\xd7\xc0\x35\xc0\x2e\x7e\x4f\x43\x92\x4a\x7a\x03\x95\x8e\xfb\xdc\x27\x07\xe3\x9e\x2b\x55\xdb\x67\x2f\x47\xe6\x38\x33\x8b\xef\x27\x3b\x55\x1f\xfc\x21\xde\xcd\xf5\x70\xbb\xbc\xdc\xb6\x33\xec\x79\x3a\xb4\x8f\xb7\x73\x25\x6e\xca\xfe\x8f\x3e\xd1\x33\x9a\x65\xc0\x1e\x7a\x17\x96\x6c\xac\xbd\x9f\xbd\x0f\x15\x70\xa3\x94\xdb\xe6\x69\x6a\xdf\xe2\x36\xe9\x9c\xae\x73\x3b\x6c\x19\x13\xb8\x39\x9c\x19\x7a\x9d\xfb\x1b\x58\xe6\xea\xfe\xa6\x6d\xc2\xca\xb2\x31\xe4\xd6\x39\x54\x00\x61\x4c\x16\x0b\x60\x99\xf5\x7d\x07\xe0\x34\x06\xbd\xe3\x78\xcf\x9b\x40\xe5\xd2\xb7\x06\x86\x0a\xd1\xb9\x82\xf7\xe0\x35\xaf\x82\xe2\x3c\xcb\x73\x05\x8f\xc3\x98\x15\x32\xc0\x71\xd6\x63\x05\x03\x1d\x91\xb8\x90\x8e\x29\x1b\x3e\xe4\x81\xa2\x14\xf7\xde\xa2\x7c\x1f\xbc\x25\xe7\x66\x00\x59\x0e\x68\x88\x0e\x62\xc9\xe9\x3c\xe8\x76\xf5\x37\xea\x64\x77\xbe\x28\x54\x4e\xc6\x62\xf8\x69\xb1\x2e\x77\x94\xb5\xab\x26\x12\xd2\x61\x1a\xc9\x56\x7f\xcb\x2e\xe1\xf4\x51\xdb\x27\x6d\x1e\xdd\x0f\x06\x24\x9d\x75\x35\x5b\x9d\x17\x9f\x07\xfa\x10\x55\x19\x42\x5d\xc5\xd9\x26\x5f\xe7\xea\x4a\x88\xb2\xf1\xb3\x0d\xa4\x9c\xdd\x03\xa6\xaa\xd6\x6f\xb7\x41\xd5\x14\xf7\xc0\xb5\xde\xae\x96\x5b\x50\xbe\x49\x55\xd9\x78\xa9\xd4\xd6\xe9\x5a\x50\x27\x89\x1b\x21\xac\xa2\x3b\x37\xac\xf1\x11\x45\xcd\x55\xfb\xfd\x16\x8b\xeb\x9d\xdc\x5a\x7f\xe2\xe7\x54\xee\x9b\xc2\xba\xe2\xef\x3e\x0d\xb8\x99\x98\xe9\xb8\xb1\xb3\xe8\xdf\xb7\x50\xaf\xdc\xbc\x53\xae\x53\x26\x81\x83\x90\x94\x4d\xcb\x62\xe9\x43\x99\xf9\x8b\x04\x5d\xe8\xd5\xf5\x83\xe0\xe4\xe9\xc5\xa0\xf7\xf4\xf2\xee\xe4\x62\xd0\xfb\xb7\xcb\x8b\x41\xef\xe7\xcb\xbb\x8b\xc1\xf1\xe5\x0b\xfd\x53\xff\x79\x11\x8e\xe2\x7f\x0e\x5d\xd8\x9f\xce\x69\x64\x54\xbd\x20\xbd\xaf\x67\xbd\xff\x1e\xf4\x7e\x8e\xff\xf2\xf8\xe0\x87\x7f\x79\x72\xd4\x1f\xbe\xf8\xdb\xd5\xff\x7c\xbb\xdb\xfc\x6f\xef\xf2\xe8\xdf\xeb\xf9\xcb\xe0\x45\x52\x3f\xf5\x2e\xbf\x0d\xa2\x1f\x8f\x37\xce\x7c\xf8\x22\x78\x91\x8c\xe2\x07\x71\x84\x4f\x3c\x6d\x82\xd1\xea\x49\x32\xea\x8f\xfa\x61\x70\x31\xca\x48\xef\xeb\x28\xee\x5d\x1e\xa9\x95\x5d\xe8\x87\xcb\x6f\x27\xd1\x8f\x9b\xd6\x0a\x26\x83\xde\xcf\xa3\xde\xe8\x60\xd4\xbf\xfc\x76\x32\x88\x36\xde\xfc\x52\x00\xd7\xf5\xb2\x3b\x28\x20\xe5\x20\xbd\xa1\x05\x11\x62\x15\x14\x3c\x7c\x91\x79\xe3\x29\x87\x2c\x10\x77\xc0\x54\xce\xee\x8b\x26\xfa\x7d\x7b\x70\x75\xd7\xbb\x8b\xc3\x17\xb2\xb8\x06\x56\xcd\x5f\x6e\x6d\x26\x55\x39\xc4\x0d\x85\xd5\x15\x27\x2b\xdb\x50\xfa\x48\x56\x36\x55\xb0\x9f\xab\x75\x71\xcc\x60\x9d\x2d\xe7\x0b\xcb\xf5\x0e\xd6\xaf\x96\xf3\x85\xc7\xb9\xfb\xad\xf1\x77\xf4\x95\xcc\x97\x49\xb0\x42\x2f\x73\xba\x18\x17\x84\x67\xff\xf1\x29\x38\x8c\xc7\x92\x1d\x46\xf5\xcb\x24\xdb\x87\x4b\x90\xcd\x50\xe2\x29\xc8\xd7\x39\xa8\x9f\xbf\xdc\x9e\x67\xc1\xa1\x77\xb3\x0e\x43\xaf\xc4\xec\x6a\x23\x35\x0c\xb3\xa5\x17\xdd\x32\xa9\xeb\x84\xca\x78\x8a\x3b\x2a\x11\xcf\x9e\x0d\x6f\xd7\xe6\xd2\x2a\xeb\x97\x12\x0e\x4f\xd9\xf0\xee\x24\x4a\xed\x96\x84\xb1\x5a\x45\xe0\xf7\x03\x1a\xfb\xb6\xff\xc2\xee\xd1\x72\xcb\xda\x76\x99\xa3\x5b\xe7\x1d\x2b\xab\x61\x1b\x0b\x9b\x82\x7c\x57\x08\x59\xb6\x54\xef\xfb\x5c\xc1\x79\x67\xf2\x07\x57\x5e\xd6\x46\x25\x3c\xa5\x72\xb6\x1c\xe3\x50\xbf\xb0\x54\x91\xc9\x76\xdb\xde\x96\x13\xde\x71\x51\x83\xbf\x92\x31\x76\xbe\xee\x5a\xb2\x94\xc8\xef\xfa\xbe\xab\xd4\xac\xeb\xfb\x30\x37\xf7\xb1\x9f\x6d\xd5\xad\xaf\xe3\xa7\x83\x56\xb7\xab\x6a\xd9\x19\xf2\xae\x96\x69\x93\xc6\xf9\x5e\x4d\x41\xea\x26\xdf\x9f\x7f\xff\x47\xbd\xb8\xfb\x3e\xfb\x72\x13\xc9\xce\x16\xaf\x83\xf4\x0b\x65\x84\xdf\x3a\x20\xaa\xa0\x6a\x00\xf5\x2f\x46\xeb\xc1\xa0\x37\x5a\x0f\x7e\x1a\xad\x07\xaf\x7b\xa3\xf5\xf1\x9b\xcb\xbe\xfe\xa6\xab\x24\xaf\xf0\x66\x74\x3a\xcb\xe9\x74\x56\xbe\x09\x77\x23\xa4\x7b\xb8\x67\xe4\x56\x48\x92\x5e\x7b\xce\x68\x6b\x4c\x8d\x27\x05\x7f\xed\xe5\x79\xb6\xcf\x56\x19\xdb\x02\xa2\x61\xf5\xb3\xea\xcf\x19\xe2\x08\xe1\x67\x73\xc2\xaf\x9f\x1f\x1c\x3f\xeb\xeb\x1f\x7e\x9d\x54\x2d\xd6\x02\xd4\x4d\xec\x66\x0d\xb7\xe7\xa9\x3e\xd3\x24\xfa\x23\x59\x84\x5f\x41\x0e\x12\xb0\x9f\xa1\x9a\x8b\x86\x86\x08\x3f\xcb\xe8\x0d\x4a\xd5\xe5\x1c\x1e\x92\x1c\xb8\x44\xfa\x6f\x8f\xb2\x49\x71\x88\x78\x91\x83\x19\x3f\x7c\xae\xd3\x23\x93\x99\x16\x0c\xfd\x20\x90\x2c\x90\x00\xb0\x70\x02\x15\x13\x94\x69\x79\x99\x6e\x8f\x8b\xf8\x59\x3f\xa3\x37\xcf\xb1\x9b\x93\xce\x0a\x21\x9d\xcf\x02\xed\x8d\xf5\x13\xd7\xf2\x8d\xdc\x9b\x25\x4b\xd1\xb0\xbd\xe8\x1d\xae\xc3\x7d\x1d\x53\x86\x18\x33\x53\x6d\x0b\xfe\x41\xe0\x48\x6b\xd1\xf9\xe6\xcf\xa2\x1f\xfa\x05\x3b\x7a\xac\x5c\x5a\x4f\xc9\x8a\xda\x41\xb4\x39\x64\x5c\xd4\xa1\xe3\xf9\x0e\x33\x2a\x54\xfe\x98\x1d\x36\xf2\xd1\xd6\x52\xc4\x82\x32\x06\xdc\x5b\x89\xd2\xf3\xf7\xa5\x34\x8a\x46\x8e\x7d\x82\x70\x47\x0d\x53\xed\xf5\xda\x9a\xdc\xed\xed\x96\x5f\xc7\xb8\x3d\x82\xce\xbb\x58\xc2\xac\x0a\x5e\x7e\x6d\xa2\xe2\xf0\x7f\xe9\x87\x00\xf7\xbf\x90\x1b\x22\x52\x4e\x17\x52\xf4\xab\x2b\x78\x55\xd2\xc6\x5f\x44\x6d\x52\x33\x54\xb0\xda\xe5\x6d\xeb\x30\x7c\x97\x41\xae\x62\xdd\x8a\xe8\x3c\x2a\xcd\x33\xaf\x57\xbf\xc3\x61\x94\x0a\xc5\x95\x83\xb9\xe7\xc8\x35\x0e\x9a\xc7\xa2\x8c\xf5\xae\x3c\x0c\xda\xa6\x91\xa7\x96\x97\xb4\xe0\x8e\x10\x17\x79\xc4\x63\x22\x20\x41\x78\x06\xeb\xc6\x84\xfe\xf4\x22\x41\x3f\x35\xc8\x6f\x25\xbc\xe5\xc5\x72\xa1\x8b\xfe\x63\x7f\x52\x69\x9c\xe8\xcf\x5d\xfd\x71\x22\x52\x4a\xbb\x26\x72\xca\xe0\xfd\x72\x3e\x06\x2e\xba\xa6\x85\xbc\xcd\x21\x69\xac\xce\xe5\xfa\x15\x26\x32\x41\x87\x87\xd1\x56\x8a\x8f\x6a\x37\x12\x74\x98\xb4\x68\x84\xde\x17\x83\x70\xb7\x65\xda\xb2\xb7\xe7\x67\xb0\xde\x26\x7d\x06\x6b\xcb\xd7\x35\xf7\x7e\x99\xe7\x09\x3a\x8c\x5b\x73\xac\x60\x1f\x38\x65\xba\x22\xec\x24\x28\x75\xda\xc2\xbf\x71\x9e\x36\xfb\x1c\xb1\xd6\xd1\xef\xf2\x59\xde\xff\x4b\xa1\x8c\xae\xe5\x3d\x0e\x1b\xdb\x52\xb6\xcb\xdb\x59\xa0\xdf\x07\x6e\x15\xd8\x1e\xab\x93\x15\x37\xd8\xea\xce\x66\x64\x7d\x4f\xd8\x72\x79\xc6\x1d\x2c\x0a\x51\x65\x38\xce\x75\xdb\x44\x5d\x3e\xff\x7b\xdc\xe4\xff\x2b\x72\x6c\x8d\x8d\x2b\xc2\x19\x65\xd3\x46\x78\x54\x91\x1a\x09\xfa\x15\x90\x2c\x0a\x94\x13\x3e\x55\xbf\x50\x46\xc5\x22\x27\xb7\x88\x32\x75\xd4\x63\xa4\xa3\xa8\x92\xac\x62\xe8\x5b\x2a\xdf\x2d\xc7\x36\x4c\x6e\xdb\xdb\x8d\xdf\x58\xd5\xad\x8b\xff\x0b\x00\x00\xff\xff\x42\x4a\x4e\x5b\xb9\x34\x00\x00")
+var _staticJavascriptsApplicationJs = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc5\x3c\xed\x72\xdb\x38\x92\xff\xf3\x14\x18\xc6\x33\x26\x13\x89\x92\x73\x9b\xd9\x59\xd9\x71\x2e\xdf\xf1\x56\x26\x49\xc5\x99\xbb\xaa\xb3\x35\x3e\x8a\x84\x2c\x8e\x29\x52\x45\x52\x56\x3c\xb6\xb6\xf6\x69\xf6\xc1\xf6\x49\xae\x1b\x1f\x24\x00\x82\x94\xe4\xb9\xad\x9d\xda\x8d\x25\xa2\xd1\x68\x34\x1a\xfd\x4d\x5d\x07\x39\x39\x2d\x83\xb2\x20\xcf\xc8\xcb\x20\xbc\x9a\x64\x29\xf5\x7f\xce\x22\x9a\xf8\xf4\x5b\x49\xd3\xc8\xbd\x7d\x40\xe0\xbf\x65\x9e\x8c\x88\x33\x28\x10\xd4\xe9\xb1\x47\x11\x9d\x06\xcb\xa4\x2c\x46\x84\x83\xe0\x7f\x0e\xe2\x5a\x16\x0e\xc0\xc6\x69\x5c\xc6\x41\x12\xff\x1e\xa7\x97\x62\x86\x84\xc8\x4b\x1a\xbd\x28\x01\x28\x5d\x26\x89\x32\xf4\x16\xe6\x14\x33\xfb\xd8\xe7\x3c\xbb\xcc\x69\x81\xa8\x87\xca\xe3\xaf\x41\x7e\x49\x4b\xf3\xe9\x17\xba\xc8\x8a\xb8\xcc\xf2\x98\x9a\x43\xaf\xb2\xf9\x3c\x6e\x4c\x78\x1b\x27\xb4\xf9\x2c\x8d\x80\x76\xe5\xf1\x9a\xff\x89\x0b\x49\xe8\x88\x4c\x97\x69\x58\xc6\x59\x4a\x5c\x4f\x61\x43\x4e\xcb\x65\x9e\x92\x72\x16\x17\x3e\x90\xe7\x4a\xb6\x78\xe4\xd9\xb3\x67\xc4\x99\x8a\xe9\xce\xa1\x8a\x36\x5a\xe6\x01\xa2\x6a\x43\x1a\x4f\x89\xab\x61\x14\x6c\xe4\x48\x91\x5d\x2a\xb4\x42\x86\x33\x1c\x8e\xd8\xff\xc4\x7a\x6c\xcd\xea\xd3\x35\x48\x00\x9c\xf3\xa1\xf6\xa0\x40\xec\x20\x12\xaf\x83\x92\xfa\x8b\x20\x2f\xa8\x7d\x69\xef\xb0\x49\x5e\xcd\x1e\xd7\x33\x29\x82\x85\xda\xb0\x2a\x87\xaf\xa2\x5d\x13\x9a\x14\xb4\x1d\x4d\x9a\xad\x5c\xaf\x6d\x5f\xf3\x38\x49\x62\x14\x6d\x9c\xd0\xe7\xbb\x32\x36\x4a\xc3\x2c\x8d\x10\xe4\xe7\xa0\x9c\xf9\xd3\x24\xcb\x72\x57\x4c\x1b\x90\x83\xe1\x70\xe8\xe9\x13\x90\xcf\xb8\x30\xcc\x48\xe9\x8a\xd1\xe0\x32\xde\xd7\x60\x12\xc4\x2f\x68\x79\xca\xf1\xbb\x62\x1d\x05\x4a\x1c\x4e\x05\x5c\x66\x27\xa7\x9f\x4e\xcb\x1c\x44\xce\xf5\xfc\x62\x39\x29\xca\xdc\x3d\x38\xe8\x91\x9f\xbc\x4a\x4c\xd6\xf0\x71\x05\x62\x99\xad\xfc\x42\x5c\x5a\x24\x82\x5d\xe0\xc3\x07\x0f\x90\x3e\x21\xb5\x1b\xae\x73\x0c\x6c\x86\xa5\x26\xcb\x92\xc2\x55\x3d\x89\xc4\x05\x2d\x69\x51\xe2\x55\x38\x01\x1c\x61\x00\xf7\x07\x2e\xf7\x99\x83\x4f\x9d\x1e\x71\x2e\x8a\x05\x0d\xf1\xc3\x34\xfe\x06\xb4\x53\xfc\x38\xcf\xc2\x2b\xfc\x5b\x94\xcb\x09\x1b\x0a\xae\xd8\xf3\x88\xce\x33\xf6\x3c\x98\x2f\x12\xea\x8c\x39\xfe\x62\x96\xe5\x25\xbf\x81\xef\x83\x62\xb6\xf5\xf5\xa9\xa7\x38\x15\x6b\x86\x3d\xf2\x67\x4f\xbb\x40\xb0\xa1\xf9\x9c\x46\x1c\xf8\x67\xd0\x15\xc1\x25\x6d\x5b\x82\x49\x07\x07\x01\x56\x99\x2b\x89\xc9\xb8\xd8\x22\x89\xe1\x71\x1f\xff\x7b\xf3\xf1\x35\xf9\xfc\xee\x33\x39\x3d\x79\xf7\xf1\xc5\xd7\x5f\xbe\xbc\x61\x4f\x61\x97\x4f\x3c\x7f\x91\x2d\xdc\xe6\xe1\x8a\x15\xfc\x9c\x2e\x92\x20\xa4\xee\xe0\xd7\xf3\xe2\xbc\x78\x34\x00\xc6\x00\xee\xea\x29\x7b\xb8\xc7\x9f\xea\x8a\xe6\x2b\xb0\xfe\x0b\x4d\x40\x3e\xa2\xae\x9d\x2c\x40\x76\xb5\x6d\xe0\x21\x7e\x86\x87\xb0\x4a\x99\x7d\xc8\x56\x34\x7f\x15\xc0\x6d\x53\x28\x9c\x66\x39\x71\x71\x6e\x0c\x13\x87\x87\xf0\xe7\x88\xcf\x6f\xca\x80\x9f\xd0\xf4\xb2\x9c\x01\xcc\xe3\xc7\xe6\x85\xc6\x5b\x8f\xab\xfb\x20\x76\xf4\xdb\xa7\xa9\xdb\x82\xe3\x2c\x1e\x7b\xe4\x98\xf4\x0f\x4c\x04\xea\x79\xe7\x4b\x7a\xa8\x0d\xae\x2d\xf7\x5a\x00\x4f\x03\x50\x0b\x87\x3a\xb7\x3e\xd2\x55\x17\x97\x40\xed\x5e\xd2\x7c\x01\x37\xac\x34\x98\x55\x3d\x77\x0c\x85\xf6\x9d\x3a\xe7\xee\x8e\xe0\x77\x66\x14\xfe\x2b\xa6\x2b\x7f\x02\x3c\x4d\xe2\x94\xb6\xe8\x5d\x85\x44\xeb\x16\xbe\xbb\xf0\x41\x29\x94\x41\x9c\x16\xae\x15\x6f\x4f\x25\x59\x97\xf5\x2c\x0c\x97\x79\x4e\xd3\x90\x16\xff\xea\x2d\xb3\x59\x61\x96\x24\x94\x2d\xd2\xb2\xd9\x33\x04\x1b\x77\xec\xd6\x40\xe3\xaf\x66\x34\xa7\xee\xad\x46\xca\x48\x25\x78\xad\xef\x78\x1a\xe7\x05\xa8\x53\x9a\x6e\xd0\x1a\x17\xfe\x3c\x4e\xb9\x18\x2a\x4c\x72\xbd\x9e\x32\x4d\x70\xbb\x65\x2b\x8a\x79\x12\x80\xaa\x6e\xc0\x51\xdd\x42\xe9\x74\x26\xc1\xb6\x64\x06\xdf\xfe\x8d\x64\x4e\xe1\x7a\xbe\x02\xe9\xa3\x69\x59\xfc\x82\xce\x9d\x9d\xda\xc1\x20\xc9\xc2\x20\x21\x52\x3e\x09\xc8\xe5\x15\x29\x33\x38\x4e\x1a\xe7\x0c\x0b\x59\xc5\xa0\x7d\xe0\x3b\x10\x26\xdc\xae\x1b\x12\x97\x70\x1f\x49\x9c\xb6\x78\x2f\xa8\x1c\x60\x55\x50\x4f\x52\x6f\x38\x03\xc4\x55\x0c\x84\x3b\x33\xf4\x9a\x56\xa0\x9a\xa4\x5b\x64\x70\x4d\x41\xb6\xcf\x04\x02\x50\xc7\xf5\x8c\xca\x0f\xbc\xf9\xb4\x4a\x69\xee\x78\xf6\xc1\x8f\xc1\x9c\xea\x63\xaa\xc1\xe9\x59\xf5\xea\xd8\xff\x2d\x03\x39\x73\x06\x8e\xcd\x07\xe2\x7e\x52\xb6\xcc\x43\xfa\xf5\x66\x81\x07\xc1\xc8\x7c\x0c\xce\xdf\xf3\xa2\x7a\xfc\xcc\x21\x8f\xc1\x35\x09\xc1\x3e\xff\xf2\xe5\x04\xd6\x5c\x80\xb9\x4e\xcb\x36\x14\x0d\xf3\x02\x28\x5b\xcf\x54\x3d\x50\x38\xc0\x64\x02\xde\x40\x8f\xd0\x3c\xcf\x72\xf5\x7c\xf7\xfc\xe0\x37\x90\x43\x5d\xc0\x98\xb3\xcf\x88\x30\xa4\x04\xc4\x53\x03\x2c\x96\x21\x08\x2d\xac\x55\xad\xa0\xbb\x69\xb8\xda\x88\xff\xb1\xc9\x21\x7e\x54\x9d\x16\x2d\x08\x79\x55\xab\x0a\x5b\x24\x22\x05\x52\x78\x2e\x73\xf4\x71\x46\x12\x11\x7f\xb6\x00\xd3\x7b\x1a\xff\x0e\x3e\xc0\xd3\xa1\xf0\xe0\xab\xa0\xa4\xd5\x31\xe0\x37\x72\x3a\x05\xdf\x0d\xc9\xb9\x55\x56\xc2\x38\x00\x63\xa0\xe5\x62\x81\x91\x08\xf8\xef\xf0\x64\x7d\xa8\x4f\x9d\xd2\x32\x9c\x71\x07\xcc\xd0\xff\xf5\xf0\x8b\x4b\xd0\xf9\x3a\x80\x38\xc1\x41\xb5\x1a\x09\x72\x4a\xb2\x34\xb9\x21\xf4\x9a\xe6\x24\x88\x22\x1a\xf5\x48\x91\x11\x1a\x84\x33\xb2\x00\xe6\x10\x86\x8b\x16\xec\xea\xe1\x5e\x0b\x90\x8b\x30\xcb\x01\x90\x14\x31\x68\x13\x36\x80\xea\x08\xf0\x50\x2e\x20\x38\xa3\xc3\x4a\x56\xf2\x2b\x77\x61\xaa\x9e\xe6\x1e\x9a\x46\x9b\x0b\xa7\xcd\x12\x98\x0c\xd2\xe7\xd6\xa3\x9f\x71\x33\xae\x7a\xc6\xa0\x2e\x27\xf0\xc5\xb5\x93\x6d\x9f\xad\x9c\xd3\x16\xf3\xb7\x38\x40\x3b\x97\x18\x23\x6c\xd8\x36\x9e\x79\x3b\x30\x1c\x91\xea\xab\x35\x39\xc9\x45\x86\xcd\xd0\x74\xbc\xfe\x48\xaa\x85\x8a\x2b\xea\xb9\xc3\x3d\xea\x91\x28\xd3\xbd\x17\xbc\x8c\xb5\x8d\x16\x2e\xc3\xa1\xa2\x2d\x40\x2b\xfd\xf5\xf4\xd3\x47\x3e\xfb\x96\xdf\x93\x91\x76\x69\xce\x60\x68\xdc\x03\x3b\x01\x0a\x54\x8c\xc8\x8b\xb8\x56\x8d\x1b\x3e\x34\xd9\xa6\xb8\x07\x2a\x36\xd4\x9b\x08\x2e\x1d\xd1\xb6\x39\x70\x49\x38\xda\x43\x8b\x8f\x5a\x4d\x67\xf6\x45\x99\x25\xa9\xb3\x9d\xa1\x02\xa6\xc8\x56\xcd\x3a\xc3\x5d\xb5\xc5\xaa\x2c\xb4\x07\x58\xf3\x40\x15\xdf\xd6\xf3\xa7\x41\x9c\x74\x08\xa7\x39\xdf\xd4\xa1\x22\x26\x9c\xd6\x6a\x14\xc3\x42\xa9\x55\x5d\xa9\x66\x59\x9c\x88\x1e\xa6\xaa\x67\x99\xc7\x69\x04\x87\x23\x0c\xe8\x00\xf6\x42\xf8\xa7\x60\x3a\x35\x35\xcb\x06\x85\x92\x05\x0e\xc1\x22\x5f\xe3\xf0\x8a\xe6\x6a\xd6\x46\xa6\x33\x9a\x23\x62\xca\x09\x98\x95\xfc\x3a\x48\x76\x57\xcf\x10\x9c\x03\xb9\x5f\x33\x7e\x0b\x19\x4d\xa0\x98\xc3\x59\x00\xbe\xa3\xb4\xff\xe0\x4f\x45\x34\xf7\x0c\xf5\xc2\x32\x00\xaf\x35\xca\x5c\x2b\xcc\x67\x4e\xa3\xab\xdf\x24\x8e\x74\xa3\xf6\x64\x14\x75\xe6\x41\xc4\x42\xd9\xc2\x58\xa7\x31\xde\x4e\xeb\xba\x6d\xdd\x59\x50\xbc\x62\xac\x88\xdc\x3a\x6b\x66\xa7\x60\xb9\x88\xc0\x4d\x94\x40\x3b\x63\xaf\x32\x64\x5d\xd8\x55\x29\xdc\x11\x3b\xba\x73\xdd\xa8\x13\xba\x3b\x5e\x99\x01\xec\xc2\x2c\x60\x76\xc6\xad\x25\x1e\xbb\x16\x50\x01\x77\x5e\x45\x26\x3d\xbb\x16\x10\x30\x4d\xdc\x42\x94\x55\x29\xef\xbc\x6c\xda\x05\x07\xc5\x01\x5a\x59\xde\xdc\x0e\x8d\x55\x48\x55\x23\xc8\x67\xda\xd3\x6d\x9a\x2a\xdf\x50\x06\xfa\x7d\x53\x6e\xc8\xc6\x4b\xa7\xd3\xf9\x5d\x4b\x4a\x34\x4c\x68\x90\x57\xf4\x37\x27\x76\xb2\xeb\xb5\xa1\xd2\x3a\xb8\xa6\x83\xde\x83\x6d\xfc\x14\x25\x1a\xd7\x53\x19\xa7\xa4\x25\x15\x46\xed\x40\x9d\x89\xdc\x12\xc1\xe8\xea\x7b\x17\x7e\xea\x33\xdb\x18\xaa\x93\xd0\x46\xed\x9e\xeb\x3c\x0c\x83\x3c\xba\x90\x48\x2f\x60\x99\x25\x26\xe6\x4a\x30\x59\xea\xfd\x88\xaa\xcd\xe8\x9c\xd1\x55\x5c\x57\xae\xa4\x60\x39\x7a\x99\x26\xe1\xdf\xbe\x66\xef\x97\xf3\x40\xe3\x10\x90\x54\xc6\x65\x52\xd1\xe0\xbc\x8b\xcb\x3c\x9b\x80\xc9\x84\x60\x8e\xcf\xd2\xa1\x1f\x2e\xc4\xe2\x17\x93\x20\x97\xb3\x04\xa0\x1f\x82\xda\x75\x56\x71\x04\x21\xa5\xb8\x10\x7c\x3b\x2c\xfa\xab\xb5\x37\xa0\x76\xbe\xb7\x46\x9a\x9b\x6d\x8d\x85\x84\x9c\xce\xb3\x6b\xfa\x0a\x62\x03\x58\x5d\x8e\xf5\x61\xac\x1f\xa4\xf1\x1c\xb3\x8d\x44\x7b\x5a\x94\x79\xbc\x00\x3f\xda\xa0\xd7\x01\x41\xd4\xa8\xb2\x9c\xb0\x54\xff\x1b\x4f\x58\x3a\x2f\xd5\x09\xcf\xe2\x08\x9c\x9e\xc6\x41\xcb\xfc\x95\xb0\x3c\x2c\xbf\x09\xe1\x27\x95\xa9\x73\x74\xa6\x22\x7a\x02\xa1\xf9\x14\x42\x1f\xc7\x26\x0d\xcc\x6e\x6c\x41\x10\x40\x6d\x49\x0d\xb3\x54\xf7\x21\x45\x18\x9a\x8d\xc4\x84\x1c\x6e\x2b\x72\x2a\x03\x77\x1f\x82\x54\xc3\xb4\x91\xaa\x5c\x01\xde\x8a\x34\xdd\x3e\xde\x87\x3e\x61\xd7\x36\x92\x56\x72\xb8\xad\xa8\xaa\xec\xe9\x6e\x04\x69\x2a\x62\xb3\x66\xa9\xaf\x49\xb1\x8a\xc1\x1a\x92\x06\x1d\xb2\x4a\xd8\x50\xb2\x01\x44\x15\x7a\x41\x75\xd4\x88\x31\x2a\xf5\xe5\x9c\xa8\x80\xcd\x28\x72\x92\xd3\xe0\xea\xd0\xb2\xc0\x65\x50\xce\x68\xbe\x09\xfb\x3b\x09\x45\xd4\xd3\xdf\x65\x9d\x20\x0d\x92\x9b\x8d\xbb\x78\x21\xa1\xee\xbd\x4e\x55\x66\xed\x5a\xe6\xad\x5e\x8b\xdd\x80\x58\xd4\xbc\xbb\x10\xfe\x92\x5e\xa5\xd9\x2a\xdd\x8c\xaf\x91\x21\x17\x38\x40\xd5\x13\x17\x8d\x09\xcb\xed\x9e\xa4\x4d\x79\x55\xbd\x7a\x34\x0c\x9e\x2c\x24\x37\x0a\x84\x22\xd8\xab\x8a\x84\xf8\xdd\xbd\xc5\x10\x0e\x2f\x8a\x19\xe3\x79\x66\x42\x6e\x73\xac\x58\x06\x97\x98\x3d\x05\xeb\x57\xca\x18\x91\x5e\xf3\xfc\xa3\xd2\x15\x10\x26\xe0\x0b\x90\x32\xc2\xfc\x7f\x9f\x15\xab\x02\x6c\x11\x28\x66\xd9\x4a\xac\xe4\x68\x15\xf6\x92\xce\x17\x58\xf4\x1a\x91\x0b\x5f\x7e\x76\x91\x62\xf9\x45\x5a\x0b\xbc\xd8\xe5\x3c\x81\x8b\xba\x4d\x80\xc6\xf8\xb8\x87\xce\x34\xce\x11\x95\x2a\x81\x5d\xe1\x71\x20\x4b\xa3\x05\xdc\x7f\xd0\x39\x81\xeb\xc8\xe5\x54\x1b\xdd\x65\x8d\x95\xba\x5d\x4b\xf0\x87\x64\x04\x51\x24\x6c\x30\x16\xcc\xfa\x39\x9f\xe0\x78\x1b\xaa\x28\x7a\x72\x27\xcb\xc1\x60\xc3\x34\x99\x9a\xee\x54\x44\x58\xcd\xac\x5c\x1c\xc3\x82\x89\x7a\xa1\xa8\x78\x0e\xcc\x34\x3b\x9a\xc3\x14\x8e\x1a\x7d\x59\x86\xc6\xac\x79\x22\x50\x14\xe7\x34\x64\x05\x00\xb1\x06\x05\xdf\x7a\x51\xc4\x05\x84\xf4\xae\x98\x56\xe5\xcd\x7b\xe4\xc7\x61\x8f\x3c\x79\x6a\x30\x52\xc1\x81\xcd\x12\x4e\x5b\x57\xc3\x11\x78\x25\x59\x7a\x79\x8c\x57\xe5\xc2\xa7\x45\x18\x2c\xb0\x12\xc2\xa9\x64\x17\xe3\x68\x20\x41\x3a\x38\x5a\x4d\xad\xd6\x65\x73\x07\x0e\xc3\xb0\xf3\x1a\xe2\x58\x94\x7d\xab\x07\x02\xb0\x3d\x32\x8f\xd3\x0f\x2c\x13\xd5\x23\x34\xba\xa4\xfc\xb3\xba\x4b\x80\x02\xfe\x09\x1b\x04\x5f\x0c\x06\xc1\x13\x99\xca\x3a\xaa\x91\x61\xad\x4e\x1d\x79\x46\xdc\x1a\x3b\x79\x44\x9e\x78\x2d\x8c\x84\x49\xad\x7d\x21\x11\x2b\x8f\xbf\xc8\xf3\xe0\x46\xc5\xf6\x98\x1c\x78\xe2\x1c\x7d\x53\x4e\xe6\x71\x24\xa0\x9e\xa9\xf4\xf4\x89\x4e\xcd\xa1\x59\xc0\x86\x10\x02\x13\x8e\x0e\x53\x7d\x6c\x61\xe0\xae\xe7\xdf\xe2\xd7\x1a\x27\x3c\x5b\xeb\x10\xce\x61\x53\x8f\xe6\x55\x6d\x1d\x35\xdf\x17\x7a\xf9\xe6\xdb\xc2\x15\x6b\x80\xd8\x39\x7b\x07\xff\xfc\xfb\x3f\xf6\x9e\x98\xf6\xbc\x56\x47\xea\x99\x69\x89\x41\xea\x2f\x72\xa6\xe0\x5e\x73\x4b\xd0\xc8\x1e\xcd\x83\xfc\xea\x45\x71\x4a\x31\x6d\x88\x97\xdf\x60\x4e\x16\x05\x89\xa2\x94\xc5\x72\x3f\xe3\x63\xa3\x08\x23\x52\x6d\x4a\x8a\x4b\xaf\xad\x60\x15\xe4\xa1\xd0\x4b\x17\x0c\x2f\xf1\xd9\x9f\x7e\xc8\x0b\x36\x4e\x23\x5d\x28\xd0\x72\x0a\x44\x86\xcc\x08\x6d\x74\x8c\xc0\x7f\xf6\xd7\xb5\x22\x60\x31\xfd\x5b\xa5\x42\x64\x64\xcb\x74\x56\x6c\x54\xca\x61\x92\x15\xa0\x06\x41\x19\x4e\xb2\xe8\x06\x96\x46\x52\xe0\x5b\xee\x97\xc1\x24\xa1\xfd\x42\x20\x32\xe3\x17\x73\xf4\xf0\x41\x97\xa2\xb5\x02\xdb\x6a\x51\x9b\x6d\x5f\x9d\x1c\x1e\x55\xf5\x51\xbe\x73\x59\xc0\x57\xb3\x9e\x5b\xe6\x36\xa7\xb5\xe5\x65\xf5\x27\x6b\x7d\xe9\xa5\xc0\xdf\x90\x3e\x3d\x33\x5a\x13\x08\x32\x0f\x3c\xd0\x73\xa3\x62\xa3\xad\x28\x78\x92\x57\xe6\x55\x47\x55\x8d\x4e\x7c\x3f\xd5\xcb\x2b\x11\x9d\x64\xb0\x2b\x61\x48\xb9\xaf\xde\xc3\xcc\xae\x67\x17\xb0\xe2\xa2\xa0\x41\x1e\xa2\xc5\x81\xa0\xdd\xb9\xa2\x37\xcb\x85\x05\x11\x07\x92\x6b\x83\xb5\x68\x45\x58\x49\x2c\xa2\xc3\xab\xec\x4f\x0a\x2e\xbd\x8e\x5a\x76\x60\x97\xb7\x19\x22\x47\x59\xb8\x9c\xe3\x88\xa4\x26\x42\x1f\xae\xd7\xa6\x06\x4c\x4f\x9e\xfa\x30\xe5\x15\xdc\xd2\xb6\x42\x10\xf3\x48\xff\xe3\xcf\x23\xeb\xa0\xd2\xad\x21\x7a\xb7\xb4\x76\x10\xd4\x38\x71\xb6\x2c\x04\x17\x6c\x95\xa1\x0e\x97\x55\xa7\xe0\x2f\xf7\xa2\x20\x05\xb9\xff\x63\xab\xb7\x3a\xce\x6d\x55\x43\x7b\xcd\x4b\x9a\x40\xd9\xf0\xa0\x54\x74\x86\x6d\xac\xdf\x1e\xb3\xb6\xe7\x00\xce\xfd\x9a\x56\xbb\xde\x56\xe7\x18\xb8\x36\xaa\x1e\xf3\x04\x76\xb0\x10\x86\xa5\x90\x2b\xea\x1e\xab\x51\xb1\xbf\x8f\xf9\xb0\x99\x91\x6d\xcc\xc9\x4e\x66\x65\x07\xf3\x62\xa3\x67\xed\x69\x43\xec\x16\x43\xc8\x1f\xd1\x74\x07\x35\x60\xaa\x82\x65\xca\x8a\xc6\x95\x3a\x68\x59\x5f\xcb\x4f\x74\x2a\x77\xb3\x9c\xa8\xd4\x79\xd5\x5a\xed\xcb\xca\x7c\xb4\x24\x39\xaa\x32\xac\x33\x90\xa6\xc6\x31\x1a\x87\x64\x17\x55\x61\x6e\x72\x30\xc0\x7e\x9d\x6c\x59\x92\xa0\xb2\x53\x04\x0c\x6f\x81\x33\x31\xb4\xa7\xd8\xb9\x93\x66\x25\x2b\x82\x97\x19\x98\xb9\xf9\x02\x9b\x11\xaa\xea\x62\x99\x3d\xb0\xdc\xc7\x6a\xc1\xd6\x76\xe4\xb6\xcb\xa8\x5f\x44\x6b\x27\x1c\x57\x48\xd5\x12\xfa\xfc\x0b\x3f\x4e\xaf\xb3\x2b\xaa\x37\xd1\xa9\x86\x14\xac\x18\x17\x4f\xa7\xbd\x21\x4a\x33\x8a\xa3\xee\x2e\xac\xc1\x00\x3c\x02\x92\x67\x2b\xb2\xa0\xac\x87\x18\xb6\x85\xfc\xcf\x91\x7f\x6a\x6f\x1e\xef\xe0\xe0\x92\xc1\x5b\xa4\xb2\xd5\x7e\xa1\x40\x00\x77\x97\x69\xd9\xd1\xb3\xa7\xb5\x76\x75\xb4\xed\xa9\xb3\x7e\xf8\xa1\xe9\x4b\x9c\x29\x00\x63\x7b\xf3\x44\x1b\x74\xcb\xd5\x6e\xef\xf3\x50\x2c\x49\x53\x93\xf1\x14\x84\xae\xb3\xd6\xed\x9b\xd9\x8d\xd4\x9a\x61\x38\x66\xa3\x6d\xcf\x55\x00\xaa\x9d\x41\x9c\xea\xf9\xc1\x62\x01\x5f\xa5\xf3\xb4\x47\x8d\x62\x94\x66\x10\xb6\x69\x5d\x46\xcf\xb3\xd5\x87\xd5\x50\x2b\xf6\x75\x1b\xc4\xa6\x69\xc2\xe9\x2f\x92\x04\xd7\x01\xd5\x0a\x57\x17\xc2\xb2\xa8\x9f\x82\x8c\x32\x2f\x3a\x2f\x4a\x43\xcb\x18\x1e\xc5\x7d\xd6\x44\x14\x3b\xad\xa9\x7b\x72\x5d\x59\x8a\x94\xd2\x28\xc1\x2b\xbf\xe7\x63\x4b\xb7\x6b\xf7\x1a\xb1\xde\xe4\xb5\xb6\x37\xa3\x14\x49\x3c\xb6\x24\x02\x4b\x26\xe1\x91\x5c\xd4\x6a\x0d\xc3\x0e\x52\x36\xea\x23\x72\x57\xdb\x4a\x7f\x37\x6a\x6c\xdf\xea\x28\x00\x2a\xdd\xdc\x7b\x4c\x0e\xab\x30\xa8\x4e\x9e\xc9\x1a\x52\xeb\xe6\xeb\x4e\x1e\x2c\x04\xb4\xa1\xe2\xa3\x5b\x23\x53\x9a\x3b\x5b\x10\xd6\x10\x5b\x21\x6d\xb4\x8e\xf3\xf3\xe2\x6d\xe2\x98\xcd\xe0\x04\xb6\x0e\xd7\xcb\x59\x41\xec\xc6\x9d\x93\xbd\xc5\xf9\xb6\xf6\xf2\x48\x1c\xb5\x63\xd7\x82\xa0\x69\x68\xd4\xf4\xec\x54\x0f\x33\xd5\x7e\x9d\x3a\x49\x6b\x17\x24\x87\xc5\xaa\x83\x01\xda\x13\x6e\x7b\xc0\xa2\x4c\x99\x79\x69\x18\xf4\x34\x23\x49\x86\xfa\x91\x4c\xc1\xd0\x44\x3d\xb2\x9a\xc5\x10\xb0\x54\xcd\x85\x2c\x57\x0d\x1f\xc1\x12\xb1\xb4\x7c\x01\xb6\x5f\xe4\xcd\x0b\x16\x0e\x7f\xa1\x45\x96\x5c\xd3\x68\x8b\x54\xf0\xc6\xa4\x6d\x2e\x70\x99\x59\xdb\x5d\xdb\x7e\x8c\xc8\x54\x94\x4d\x7a\x6a\x6d\x7b\x17\xd7\x8c\x75\xbd\x33\x9c\x9d\x75\xd4\x8e\x1b\xaf\xf8\x63\x51\x3c\x9d\xd6\xfd\x86\x4a\xb8\x6d\x6d\xcd\x6b\xe6\xab\x71\x7e\x83\x38\x7c\xe8\xbf\xec\x7e\xdb\x40\xef\xd4\xd3\x8d\x31\xd7\x3f\x22\x25\xbb\x57\x67\x58\xe0\x60\xca\x1b\xf5\x5e\x5e\x70\xfd\xc4\xd6\x93\x27\xaf\x3a\x96\x97\x79\xb6\x5c\x58\x74\xe9\x51\x99\x1f\xcb\x53\xd5\xf3\xea\x7c\x86\x6a\x5c\x71\x6d\x4b\xcf\xa2\x99\xb6\x01\xc1\x91\xf2\x72\x11\xd1\x22\x94\x3a\x45\xa3\x4d\x06\x80\x58\x33\x11\x57\x40\xde\x08\x26\xef\x64\x72\xc3\xea\xf2\x1a\x03\x19\xf4\x0a\x1d\x5d\x30\x5b\x02\x2e\xc0\xf6\xcc\x1e\xdc\x81\xa8\x86\x07\xef\x5c\xc5\x2f\x11\xe3\xdd\x81\xeb\xea\x5b\x33\x4d\x98\x78\xb0\xbc\x08\xa4\xa5\xed\xd5\x96\xbd\x5c\xbf\x5e\x3c\x5d\x59\x3f\x92\x6a\x00\x7b\x14\xeb\xeb\x63\xe6\xaa\x58\x70\xf8\x47\x2f\xa7\x19\xa1\x19\x37\xb4\x04\x7e\x15\x25\x00\xf0\xb4\xf4\x67\x9e\x4c\xc5\xf7\xc3\x6a\xaf\xd8\x75\x9f\x3c\x3d\x1b\xf6\x9f\x8e\xef\x9e\xc0\x9f\x3f\x8d\xe1\x9f\xbf\x8c\xef\xce\x86\x07\xe3\xe7\xec\x23\xfb\xe7\xb9\x77\xee\xff\x7b\xe0\xbc\xc1\xe5\x3c\xee\x29\xe4\x9e\x05\xfd\xdf\x5f\xf4\xff\x07\x46\xfd\xef\x1e\xee\x7d\xff\xc3\xa3\xc7\x83\x67\xcf\x7f\xbd\xf8\xdf\xdb\xbb\xf5\xdf\xfa\xe3\xc7\xff\x59\x8f\x8f\xdd\xe7\xa3\xfa\x5b\x7f\x7c\x3b\xec\xfd\x78\xb0\x56\xc6\xbd\xe7\x00\x71\xee\xef\x34\xc3\x7b\xd4\xa0\xc8\x3d\x5f\x3d\x1a\x9d\x0f\xce\x07\x9e\x7b\x76\x1e\x01\xf0\xb9\x0f\x84\xe0\x0e\xcf\xd8\x97\xf1\xed\x93\xde\x8f\x6b\xeb\x4e\xa6\x80\xf4\xbc\x7f\xbe\x77\x3e\x00\xa0\x61\x6f\xdd\x80\x59\x16\x70\x60\x58\xb6\x30\x07\xb8\x60\x37\x1e\x2f\xc0\xb8\xad\xdc\x2c\xf7\x9e\x47\x8d\x31\x98\x10\xb9\xc5\x1d\x44\xc9\xa0\xb7\x9b\xe4\x04\xec\xb5\x00\xf7\xe2\xae\x7f\xe7\x7b\xcf\x4b\x88\xcd\x52\x05\x66\xbc\xa1\x4e\x58\x25\x0b\xae\x41\x8c\x2f\xf2\x60\x25\x6b\x85\x5f\x82\x95\xcc\x05\xa8\x6f\x14\xdb\x66\xcd\xe8\xb7\x68\x39\x5f\xc8\x99\xef\xe9\xb7\xd7\xf0\xd5\x98\xbd\xfe\x17\x15\x0d\x95\x57\x41\xe1\x3e\xbf\x4a\xe2\xc5\x24\x0b\xf2\xe8\xaf\xa7\xee\xbe\x3f\x29\xd3\xfd\x5e\xa3\xed\x59\x54\x60\x47\x44\x26\x1f\xd0\xa2\xbc\x49\x28\x7e\x7c\x79\x73\x12\xb9\xfb\xda\xf5\xdc\xf7\xac\xb9\xfd\xb6\x1a\xa1\xc1\xbb\xae\xde\x89\x06\xeb\x55\x47\x87\xc7\x00\x4e\x4b\xfe\x55\xe3\xbb\xe1\x63\xd9\x67\xb2\xbd\xb0\x3e\x1b\x65\x1e\x6f\xd4\x68\x05\x0c\xe5\x11\x7a\x9a\xae\x55\x76\x6a\x9c\xf5\x8e\xbb\xdd\x82\xec\x96\x0d\x6f\xe2\x93\x7d\x13\x1b\xb6\x5b\xa3\xb7\xec\x16\x64\xe4\x7d\x56\x94\xbc\x00\xdf\x51\xf5\xad\x5e\x22\xb2\x54\x7e\xd5\x37\x8c\x8c\x32\xa3\x32\x0d\x63\x28\xd6\x19\xe3\x54\xef\x65\x39\x51\x5c\x5c\x39\x6d\x53\x2a\xb0\xfa\x51\x6b\xb9\x7c\xd7\x37\xc2\x6c\x2b\xdb\x7b\xa0\x4c\x84\xfd\x01\x8f\x29\x10\x27\x76\x34\x62\x94\x20\x91\xbe\x8b\xcb\x0f\xc1\xa4\x59\x4d\xc4\x81\xd9\x72\xe2\xd8\xab\xee\x1f\xe0\xda\x7e\x5c\xce\x27\x5b\x77\x85\x8b\x36\x28\x14\xb2\xc8\xf1\x3a\x7c\x39\x15\xfe\x53\xa2\x2c\xc4\xbb\x01\x89\xcb\x25\x35\x02\xdb\xcc\xe0\x45\xb8\xe4\x39\x5b\x34\x13\x33\xa4\x2a\xc6\x2d\xe9\x50\xa7\x6c\x7a\xd5\x73\x13\xf9\x59\x12\xb1\x9e\x02\xcf\x31\x5e\xe2\x06\x2e\x62\x1b\xc4\x7d\x5f\xe3\xe6\xeb\xda\xde\x05\x37\xf3\x03\xf2\xed\xec\xba\x62\x7e\xf0\x74\xd8\xc2\x89\xaa\xf8\x2f\x26\x79\xdb\x74\x14\xc8\x05\xea\x37\xd6\x71\x01\xb6\xff\x7f\xfe\xfd\x1f\xce\xe1\xce\xef\x7a\x37\xf9\x6b\xb4\x9a\x18\x28\x5f\xc6\x69\x90\xdf\x68\xe1\x45\x50\x06\x16\x8c\x83\xb3\xf3\x6f\xc3\x61\x1f\xfe\xf9\x09\xfe\xff\x06\x3e\x1c\xbc\x1d\x0f\xd8\x7b\xdc\x7c\x8a\x86\x78\x16\x5f\xce\x12\xf8\x3f\xef\x68\x56\xdd\x42\x4d\xdb\xce\x82\x1b\x88\xaa\xc2\xab\x86\x35\x6d\xf5\x26\x7d\xb8\x57\x6f\xf4\x04\x89\xac\xda\x1b\xc7\x22\x71\xc3\xa9\xcb\x8f\x55\xcd\x5f\x4c\x81\x00\xf1\x08\x4b\xd0\xc7\x7b\x07\x47\x03\xf6\xc1\x96\xfd\x55\x98\x20\x11\x19\xf2\x08\x4f\x3e\x41\xec\xf2\x35\xd0\x77\xc7\x5f\xd0\xce\xc4\x88\xe5\x8d\x7c\xd1\xd0\x30\x38\x72\xcf\x07\xcf\x3d\xe9\x6c\x82\x37\x77\xf6\xeb\xf1\xf8\xd1\xf1\xe0\xd2\x68\x0d\x08\xca\x50\x79\xb5\x09\xe2\xf4\x84\x12\xd7\x65\x8f\xc9\x33\x89\x10\xbc\x7a\x1a\xb2\xb5\x3d\xaf\xb5\x29\x9b\x09\x37\xce\x3b\x3b\x18\x73\xdd\x3d\x70\x6c\xe9\x10\x49\xbc\xd9\xc9\xd3\x99\xff\xa8\x27\x2d\x8b\x99\x7b\x9b\x32\xe3\xc3\x57\x7b\x32\xee\x61\x43\x98\xfc\x3a\x1c\xaf\xbd\xad\xdf\xe7\x97\x68\xf5\xb7\xdc\xe1\x21\xb6\xe6\x1b\xac\xb7\x31\x5d\x79\xad\x7a\x51\x01\xa8\xa1\x2b\x66\x92\xab\x0b\xe4\x03\x99\x87\xf8\xe2\x15\x6f\x62\x31\xd2\xb1\xd8\x89\xf0\x47\x96\xf5\x0b\xf0\x48\x29\xab\x19\x5e\x53\x7c\x3f\xdb\x6b\x23\xc4\x39\x62\x7d\x46\xa5\xcf\x3a\xab\x40\x2f\x1c\x3b\xed\x64\x55\xd7\x4e\x84\x7d\x1f\x8c\x12\x11\x3a\xa8\xa6\x1c\x26\x95\xde\xb5\xd8\x7e\xbb\x3a\x97\xd3\xb0\xfd\x00\x51\xca\x8e\xb0\xf3\xb4\xf1\x53\x01\x0a\xf6\xbb\x3b\x75\xad\x63\x8e\x41\xa8\xd6\x16\x9d\x8a\xc8\xdb\x32\x16\x13\x0a\xaa\x00\x75\xfa\xd9\xb8\xfb\x37\x2b\x94\x45\xfb\xe4\xc0\xfa\x1b\x15\xdc\x67\x57\xef\x31\xbb\x40\xf8\x9b\x14\x3d\xb1\x50\xeb\x2f\xc8\x04\xd3\xb2\x66\x9d\x0d\x87\xbe\x7e\x85\x50\x8a\x80\x82\xd8\x0a\x2f\x51\x2b\x22\xe7\x0a\x92\x78\xff\xd9\x22\x48\x41\x1e\xc1\xad\x7c\x26\x43\x80\x3e\xa2\xd8\x67\x2d\x69\xfc\xbd\xcb\xfa\x92\xd4\x53\x35\x06\xd8\x57\x7e\xdc\x5c\x99\xed\x56\xb6\xb5\xc1\xca\xf6\x45\x38\x54\x43\x7d\xf2\x13\xe7\x92\x5b\xcb\x8a\x74\xcc\xc3\x3c\x4b\x92\xaf\x59\x8b\xe8\xda\xc4\x96\xa5\x99\xad\x6e\x33\xf1\x55\x56\x98\x52\x89\xcf\xa4\x4d\x3f\x6e\xb6\x05\xe0\x30\xa8\x26\x9f\x53\x04\x16\x2c\xe3\x89\x96\x49\x92\x85\x57\x10\x2e\x86\x14\xad\x93\xb3\x6e\x7b\xad\xa1\x51\x96\xde\xc5\xf1\x7b\xc1\xe0\x98\x47\x4b\x9c\xd7\x34\xa1\xf8\x23\x0d\x96\x12\x80\xd8\x29\xf6\xc3\x1d\x45\xf1\xb5\x94\x80\x20\xa1\x79\x49\xd8\xbf\xfd\x38\x9d\x66\xfb\x04\xf6\x40\xc5\xf3\xfd\x63\x96\x44\x12\x49\x7f\xa0\xe6\x7b\x2c\xd5\x92\x82\x52\x52\x31\x2e\x9b\x92\x88\xad\xca\x7d\xaf\xc2\x3f\x1a\x00\xfa\x63\xa7\x99\xee\x9f\x41\x60\xa1\xfc\x64\x88\x8c\x33\x6c\x95\x01\xde\x22\xff\x16\x98\x80\xe5\xb7\xce\x37\xac\x5b\x0e\x54\xed\x41\xe6\x71\xb6\x18\xa9\x6c\xba\xf3\x3d\x26\x78\x91\xa8\x96\x9e\x7c\x7d\x95\x7d\xbd\x97\x81\x3c\xc4\x58\xad\x8f\x6b\xf6\x9a\xe9\x85\x9e\x3d\x77\xb0\xaf\x84\x74\xfb\x10\x72\x60\x26\x3e\xda\x37\x2d\xda\xe1\x83\x8e\xfd\x15\x8b\x38\x85\x4d\x69\xdb\x43\xe2\x3f\x2d\x4b\x41\x7d\x4f\xe1\x9e\xa6\x2d\x36\x57\x58\xd9\xde\xbe\x95\x8d\x97\xb4\x15\x99\x53\x7f\xbe\xc1\x6d\x77\x02\x25\xc6\x55\x96\xf3\x97\xd5\x30\x6d\xf1\xdf\xec\x0b\x04\x4d\xbf\x05\xd7\x01\x5c\x96\x78\x51\x16\x83\xca\x04\x5d\x70\x58\xff\xb7\xc2\x3c\x00\x31\x90\xa5\xb5\x5f\xbe\x55\xdb\xc5\xce\x8c\xdb\xea\x95\xfe\xe6\x85\x62\xec\xb1\x99\x52\x57\x1f\x69\xfa\xb6\x9c\x7a\xd6\x5f\xe3\xb5\x74\x41\x75\xc4\xf9\x8a\x54\xb7\x4c\x46\xae\xbf\xe7\xb2\xc7\x8e\xa8\xd7\xb2\x1f\x23\x65\xe4\x58\xd2\x05\xbd\xf6\x1e\xad\xa0\xc0\x6e\x7d\x00\xec\x00\x62\x2f\x78\x8d\xc8\x4f\x1d\x68\x6e\x4a\xfa\x0e\x53\xfb\xac\x7c\x7c\xd0\x0e\x88\xfb\x1e\xb1\x5f\x7a\x68\x87\x01\xf1\x8a\xe3\x4d\x40\xb5\xf9\x2a\x36\x81\x16\xe5\x4d\x42\x47\x1d\xdc\xd3\xf1\x7d\xa0\xd3\x72\x44\xf6\xf7\x7b\x5b\xc2\x7f\x41\xf1\x80\x09\xa3\x0d\x33\x0a\x26\x36\x02\xfb\xdd\x56\xc0\x12\xf5\x26\x68\x38\xbe\xed\xa8\x06\x40\x89\x73\x33\xe4\x47\x88\x25\x00\xd0\xdf\x00\x99\x66\xe9\x67\xec\xb6\x40\x85\xb8\x05\x38\xdf\xd9\x16\xb8\xd7\xd6\x91\xf5\x6e\x57\xad\xa1\x32\xba\x0c\x85\xf1\xbb\x85\x3c\x5c\xe6\xea\xd1\xeb\x10\x1f\xde\x99\xda\x4c\x35\xb6\xf5\x46\xb6\xc6\x52\x0d\x84\x4a\x96\xb6\xab\xd1\x92\xc3\x37\x5d\xaa\x56\x02\x9a\xbd\x7c\x3d\x69\x3f\xbc\x6e\x8b\x26\xd4\xf9\x02\x2c\xaf\x4c\xa3\x28\x09\x01\x81\xaa\x5d\x07\xdf\xc7\x20\xfe\xff\x78\x10\xad\x8e\xd3\x2a\xc8\xd1\x95\x35\x7c\x27\xf4\xe8\x08\xbe\xae\x01\x3e\x53\x46\x12\x7c\x23\x10\xbd\x27\xb0\xfb\xe0\x80\xdc\x90\x38\x65\x6e\x25\x61\x2e\x16\xfb\xd5\x2b\x20\xf0\x5d\x5c\xbe\x5f\x4e\xa4\x0f\xd5\x2d\x6e\x6b\x5b\xe7\x19\x2b\x05\xfe\x1f\x18\xa6\x9e\xaa\x83\x55\x00\x00")
 
 func staticJavascriptsApplicationJsBytes() ([]byte, error) {
 	return bindataRead(
@@ -296,7 +296,7 @@ func staticJavascriptsApplicationJs() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "static/javascripts/application.js", size: 13497, mode: os.FileMode(420), modTime: time.Unix(1583791341, 0)}
+	info := bindataFileInfo{name: "static/javascripts/application.js", size: 21891, mode: os.FileMode(420), modTime: time.Unix(1792320220, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -481,7 +481,7 @@ func staticJavascriptsUnderscoreJs() (*asset, error) {
 	return a, nil
 }
 
-var _staticStylesheetsApplicationCss = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x54\xed\x8a\xe3\x38\x10\xfc\x9f\xa7\xd0\x11\x0e\xee\xe0\x64\xec\x64\x72\xb9\xf3\xfc\x5c\xd8\x97\x58\x86\xd0\x96\xda\x76\x33\xb2\x24\xa4\xce\xc7\xec\x32\xef\xbe\x48\x71\x32\xce\x26\xd9\xec\x12\x02\x46\xaa\xea\x8f\x52\x75\x83\xf8\x36\x13\x42\x39\xe3\x42\x2d\xc8\xf6\x18\x88\x9f\x67\x42\x30\x1e\x58\x6a\x54\x2e\x00\x93\xb3\xb5\xd8\x5a\x8d\xc1\x90\xc5\xe7\xd9\xfb\x6c\x06\x75\xef\x76\x18\x6e\x92\xd3\x75\xd1\xb0\xcd\x97\x57\x71\xac\x1b\x43\x28\xa7\xf1\x1e\xbf\x75\x8e\xc7\xe8\x8d\x0b\x1a\x83\x64\xe7\x6b\x51\xf9\x83\x88\xce\x90\x16\xf3\x65\x99\x7e\xa9\xd2\x01\x42\x47\xf6\x08\x58\x95\xfe\x90\xce\x3c\x68\x4d\xb6\xab\xc5\xa2\xf4\x07\x91\xfe\x55\x39\x7e\xa5\xeb\xd6\x59\x96\x91\xbe\x62\x2d\xaa\x2a\x1d\xbd\xcf\x66\x85\x85\x5d\x03\x41\xc0\x83\xb2\xcf\xb8\x89\x02\x0f\xc4\x2a\x7c\x70\x5d\xc0\x18\x65\x22\x9e\x09\x89\xde\x1a\xb7\xaf\x05\x1a\x43\x3e\x52\x4c\xb5\xed\x7b\x62\x94\xd1\x83\xc2\x94\x75\x1f\xc0\xa7\xe3\x0f\x70\x4f\x5a\xa3\xcd\x81\xe7\x2d\xd9\xd4\x67\xdc\x44\x84\xa0\xfa\x1c\x7b\x4f\x9a\xfb\x5a\x2c\xfe\x2d\xc7\xce\xe6\x0c\x8d\xc1\xcd\x09\x2b\x58\x17\xca\x19\xe9\x81\xfb\xa9\xfe\x73\xa5\xd4\x43\x7c\xe4\xe0\x6c\x77\x41\x6b\xdb\xf6\x26\x2d\x93\x40\x25\x41\xa6\x75\xad\xee\x95\x35\xc5\x17\x0d\xe8\x0e\xa7\xb4\xaa\x2c\xff\xbc\x4f\x53\x6e\x18\x88\xa7\xf8\xf5\xf8\xd4\x59\x69\x30\xd4\xd9\x5a\x04\xea\x7a\xbe\x1f\x24\xa0\x77\x91\xd8\x85\xb7\x0b\x1d\xcb\xdf\x8a\xc4\xa1\x60\x8c\x2c\x03\x1a\x60\xd4\x39\x92\xf3\xa0\x88\xdf\x6a\x51\x16\x4f\x47\x43\x44\x4f\xd6\x8e\xe6\xd1\x14\xbd\x81\xb7\x5a\x34\xc6\xa9\xd7\x0f\x47\xd7\x62\xb1\xf2\x07\x01\x5b\x76\xa2\x2a\xc7\xaf\x4c\x4f\x39\x52\x56\x19\xd1\xa0\x3a\x65\x69\x40\xbd\x76\xc1\x6d\xad\x96\xa7\xa7\x59\xae\x57\xb0\x6e\xc5\x1f\x34\x78\x17\x18\xec\x58\xf2\xe0\x34\x98\x4d\x4b\x06\x45\x01\x06\x03\xcb\x88\xca\x59\x0d\x63\xe7\x13\x43\x3c\xc4\xff\xc4\x10\xc5\x28\x8a\x1c\x90\x41\xe6\x8a\x33\x6e\x3a\x7d\xcb\xd3\xf4\xdd\xc0\x8e\xf6\x1c\x67\x59\x66\xcd\xeb\x2c\xc5\x85\xf9\x37\xa4\x37\xca\x90\x6f\x1c\x84\xa3\x12\x1c\xc0\xc6\xd6\x85\xa1\x16\x51\x81\xc1\xbf\xca\x62\xfd\xf7\x8f\xad\x6f\x94\xb3\x8c\x96\x63\xfe\x00\xba\x7a\x8e\xf3\xc4\xdf\x22\xfd\x23\xa6\xa7\x3d\x1e\xf4\x76\xf0\xbf\xc0\x9f\x22\x07\x38\xc8\x1e\x8f\x5d\x3d\x95\xe7\xb6\x6e\xa0\xe7\x69\x95\x48\xbb\x1d\x9a\xcb\x95\x3b\x2f\xcb\x46\xfd\xa7\xee\xf2\xa2\x07\xfb\x45\x03\x83\x8c\x1c\x92\x86\xa4\x5f\x6e\x57\x7e\x85\xb4\x5b\x63\x5e\x2e\x72\x7d\x5e\xfe\xff\xa9\x5a\x24\x7f\xee\x30\x30\x29\x30\xa7\x69\x18\x48\x6b\x83\x79\xef\xa6\x01\xca\x1b\x30\xfb\x9f\x76\xf9\xf4\x2c\x09\xd9\xdc\xc8\xd9\xe8\xd7\x4b\x4d\x88\x93\x22\xd5\xea\x38\x76\xa7\xf9\x7f\xba\x9e\x42\x85\x96\x31\xa4\xee\xbf\x07\x00\x00\xff\xff\x41\xd0\x88\x66\xc6\x06\x00\x00")
+var _staticStylesheetsApplicationCss = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x95\x55\xdb\x6e\xdb\x30\x0c\x7d\xcf\x57\x68\x08\x06\x74\x40\x6d\x38\xb7\xa6\x73\x1f\x07\xec\x27\x86\x22\xa0\x25\xd9\x16\x2a\x4b\x86\xc4\x34\xe9\x86\xfd\xfb\x28\xdf\x1a\x27\x4e\xd3\x25\x48\x60\xd0\x3c\x24\x75\x78\x48\x01\xfb\x33\x63\xf4\xe1\x56\x5b\x97\x32\x65\x4a\xe9\x14\x3e\x35\x36\x94\x47\x8c\x84\xe4\xd6\x01\x2a\x6b\x52\xb6\x37\x42\x3a\xad\x8c\x7c\x9a\xfd\x9d\xcd\x20\x2d\xed\xab\x74\x57\x02\x04\x87\x38\x43\xd3\xbd\xbe\x88\x65\x6c\x17\x86\x5b\x21\xaf\xc7\xc8\xad\xc5\x21\x47\x66\x1d\x15\x10\xa1\xad\x53\xb6\xa8\x8f\xcc\x5b\xad\x04\x9b\xaf\x92\xf0\x6d\x6b\xae\xc0\x15\xca\xb4\x2e\x9b\xa4\x3e\xb6\xd6\x1a\x84\x50\xa6\x48\xd9\x92\x4c\x2c\xfc\x16\x49\xf7\xd4\x3a\xe4\xd6\x60\xe4\xd5\x6f\x49\x81\x17\xc1\x48\xa9\x63\x03\xaf\x19\x38\x06\x37\x8f\x30\x78\x8e\x18\xb9\x41\x5f\x5c\x3b\x5b\x38\xe9\x7d\x14\xa0\x27\x90\x10\x22\xd7\xf6\x90\x32\xa9\xb5\xaa\xbd\xf2\x6d\x8d\x87\x52\xa1\x8c\x7c\x0d\x5c\x86\xdc\x07\x07\x75\xfb\xe2\x1d\x50\x2a\x21\xa4\x69\xc2\xcf\x73\x65\xc2\x99\xfd\xce\x4b\x70\xbc\xec\x32\x1c\x94\xc0\x92\x78\x78\x48\xba\x53\xce\x11\x32\x2d\x77\xbd\x37\x43\x11\x53\x1b\xa2\x1a\xb0\x1c\x77\x65\xce\x39\xbf\x89\xf0\xe8\xac\x29\xce\x80\x79\x9e\x4f\x02\x1b\x18\xf0\x40\xce\xb8\xba\xcd\xb5\xe2\x4e\x11\x71\x06\xa2\x90\x63\x20\x75\xf5\xeb\x75\x20\xb7\x55\xa5\x70\x8c\xd8\x0e\x12\x68\xb8\x07\xad\x0a\xea\x94\x53\x45\x89\xd7\x03\x39\x59\x5b\xaf\xd0\xba\xb7\x33\x56\x93\xff\x8c\x86\x2e\x46\xe9\x91\x02\x6a\x40\x29\xba\x68\x96\x5a\xac\xf0\x2d\x65\x49\xbc\x6e\xa5\xe2\x6b\x65\xcc\x20\x2c\xa1\x7c\xad\x81\xde\x67\xda\xf2\x97\x53\xdd\x53\x09\x1b\x92\x35\xec\xd1\x12\x17\xdd\x53\x13\x22\x64\x0a\xb9\x23\x2f\xb5\xe4\xef\xb9\x32\xe0\x2f\x85\xb3\x24\xcd\xa8\x6f\xd7\x6a\xbb\x81\x6d\xce\xbe\xa8\xaa\xb6\x0e\xc1\x74\xa5\x57\x56\x80\xa6\xd2\xb5\x64\x31\x68\xe9\x68\x5e\x48\xdb\x46\xc0\xc0\xc2\x89\x50\x6e\x22\x3e\x14\x4a\xdc\x11\x14\x55\x12\x21\x6a\xea\xee\x3c\x4f\xe7\x74\xd5\xcf\xe9\x84\xf7\x20\xde\x6e\xf2\xa3\xa6\x07\x69\x43\xca\x68\x3c\x76\x4a\xec\x38\x4d\x59\x66\xc1\xf5\x9c\xa0\x03\xe3\x73\xeb\xaa\x94\x79\x4e\x85\xdf\x25\xf1\xf6\xdb\x39\x09\x3b\x3a\x09\x4a\x83\xbe\x79\x00\x35\xd1\x9e\x61\x3f\x4c\xc1\xee\xd9\xa9\xb5\x94\x47\xb1\xaf\xea\x4f\x45\x18\xfb\x56\x70\x8c\x4a\xd9\x9e\x6e\x9d\x0c\xc7\x9b\xc8\xc8\x06\xa2\xc2\x0e\x3a\x4f\xa5\x4c\xb0\x46\xa7\x92\xa2\x3d\x3a\x9a\xab\x69\xbd\xb8\x22\x83\xbb\xe5\x7a\x75\xcf\x16\x9b\x07\xfa\x7b\xbc\x27\xdd\xae\xce\xf8\x6a\xb9\x6e\x2a\x39\xe2\x44\x2f\x97\xfd\xdc\x5c\x2c\x9b\x89\x83\xcf\x9b\x4a\xcd\xbe\xca\xce\x6f\x9f\x79\x92\x64\xfc\xf1\x3a\x92\x96\xa7\xf9\x25\x80\x64\x42\xfa\x0b\x4c\x28\xf1\x3c\xdd\x88\x0b\x4f\xb3\xd7\xfa\xf9\x2c\xdb\xcf\xd5\xf7\x1f\x8b\x65\x5b\x38\xed\x60\x54\xa4\x96\x7e\xe8\x2b\x5a\xc5\x5a\x76\xd7\x4f\xd8\x16\xcd\x15\xd0\x0c\xba\x7a\xed\xec\x1f\x90\x7f\xb9\xd3\x83\xb5\x6f\xf4\x62\xd3\xf3\xd5\x37\x68\x3d\xb5\x78\x38\xb5\x5d\xba\xc0\xc6\x3f\xd2\x7b\x9f\x8b\xe7\x07\x00\x00")
 
 func staticStylesheetsApplicationCssBytes() ([]byte, error) {
 	return bindataRead(
@@ -496,7 +496,7 @@ func staticStylesheetsApplicationCss() (*asset, error) {
 		return nil, err
 	}
 
-	info := bindataFileInfo{name: "static/stylesheets/application.css", size: 1734, mode: os.FileMode(420), modTime: time.Unix(1582905682, 0)}
+	info := bindataFileInfo{name: "static/stylesheets/application.css", size: 2023, mode: os.FileMode(420), modTime: time.Unix(1792314293, 0)}
 	a := &asset{bytes: bytes, info: info}
 	return a, nil
 }
@@ -0,0 +1,117 @@
+package core
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"time"
+
+	"github.com/codeEmitter/gitrob/common"
+	"gopkg.in/src-d/go-git.v4/plumbing/object"
+)
+
+const CheckpointInterval = 30 * time.Second
+
+// the analysis progress of a single repository, persisted so that an interrupted scan can be resumed
+type RepositoryCheckpoint struct {
+	CloneUrl        string
+	Completed       bool
+	LastCommit      string
+	CommitsAnalyzed int
+}
+
+func (s *Session) repositoryCheckpoint(repo *common.Repository) *RepositoryCheckpoint {
+	if s.Checkpoints == nil {
+		s.Checkpoints = make(map[string]*RepositoryCheckpoint)
+	}
+	checkpoint, ok := s.Checkpoints[*repo.CloneURL]
+	if !ok {
+		checkpoint = &RepositoryCheckpoint{CloneUrl: *repo.CloneURL}
+		s.Checkpoints[*repo.CloneURL] = checkpoint
+	}
+	return checkpoint
+}
+
+func (s *Session) IsRepositoryCompleted(repo *common.Repository) bool {
+	s.Lock()
+	defer s.Unlock()
+	return s.repositoryCheckpoint(repo).Completed
+}
+
+// drops the commits of the history up to and including the last one analyzed before the session was interrupted
+func (s *Session) RemainingHistory(repo *common.Repository, history []*object.Commit) []*object.Commit {
+	s.Lock()
+	lastCommit := s.repositoryCheckpoint(repo).LastCommit
+	s.Unlock()
+	if lastCommit == "" {
+		return history
+	}
+	for i, commit := range history {
+		if commit.Hash.String() == lastCommit {
+			return history[i+1:]
+		}
+	}
+	//the commit is gone from the history, e.g. after a force push, so start over
+	return history
+}
+
+func (s *Session) CheckpointCommit(repo *common.Repository, commit *object.Commit) {
+	s.Lock()
+	checkpoint := s.repositoryCheckpoint(repo)
+	checkpoint.LastCommit = commit.Hash.String()
+	checkpoint.CommitsAnalyzed++
+	s.Unlock()
+	s.SaveCheckpoint(false)
+}
+
+func (s *Session) CompleteRepository(repo *common.Repository) {
+	s.Lock()
+	s.repositoryCheckpoint(repo).Completed = true
+	s.Unlock()
+	s.SaveCheckpoint(false)
+}
+
+// reports whether the session is persisted as it goes, to a database or to the file it is saved to
+func (s *Session) CheckpointsEnabled() bool {
+	return s.Store != nil || *s.Options.Save != ""
+}
+
+// writes the session to its database or checkpoint file, at most once per CheckpointInterval unless forced
+func (s *Session) SaveCheckpoint(force bool) {
+	if !s.CheckpointsEnabled() {
+		return
+	}
+	s.Lock()
+	defer s.Unlock()
+	if !force && time.Since(s.lastCheckpoint) < CheckpointInterval {
+		return
+	}
+	location := *s.Options.Save
+	s.Stats.Lock()
+	var err error
+	if s.Store != nil {
+		location = *s.Options.Database
+		err = s.saveToStore()
+	} else {
+		var sessionJson []byte
+		if sessionJson, err = json.Marshal(s); err == nil {
+			err = writeFileAtomically(location, sessionJson)
+		}
+	}
+	s.Stats.Unlock()
+	if err != nil {
+		s.Out.Error("Error saving checkpoint to %s: %s\n", location, err)
+		return
+	}
+	s.lastCheckpoint = time.Now()
+	s.Out.Debug("Saved checkpoint to %s\n", location)
+}
+
+// writes to a temporary file first so that an interruption never leaves a truncated session behind
+func writeFileAtomically(location string, data []byte) error {
+	temporary := location + ".tmp"
+	if err := ioutil.WriteFile(temporary, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(temporary, location)
+}
@@ -0,0 +1,253 @@
+package core
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/codeEmitter/gitrob/common"
+	"github.com/codeEmitter/gitrob/matching"
+	"github.com/codeEmitter/gitrob/store"
+)
+
+const storedSessionKey = "session"
+
+// the parts of a session that are not kept in buckets of their own
+type storedSession struct {
+	Version        string
+	Stats          *Stats
+	Logins         []string
+	IsLocalSession bool
+}
+
+// opens the session database, continuing the session it contains or importing the session loaded from a file
+func (s *Session) openStore(path string) error {
+	st, err := store.Open(path)
+	if err != nil {
+		return err
+	}
+	s.Store = st
+
+	var stored storedSession
+	var found bool
+	err = st.View(func(tx store.Tx) error {
+		if found, err = tx.Get(store.BucketSession, storedSessionKey, &stored); err != nil || !found {
+			return err
+		}
+		s.Targets = nil
+		s.Repositories = nil
+		s.Checkpoints = make(map[string]*RepositoryCheckpoint)
+		err := tx.ForEach(store.BucketTargets, func(data []byte) error {
+			target := &common.Owner{}
+			s.Targets = append(s.Targets, target)
+			return json.Unmarshal(data, target)
+		})
+		if err != nil {
+			return err
+		}
+		err = tx.ForEach(store.BucketRepositories, func(data []byte) error {
+			repository := &common.Repository{}
+			s.Repositories = append(s.Repositories, repository)
+			return json.Unmarshal(data, repository)
+		})
+		if err != nil {
+			return err
+		}
+		return tx.ForEach(store.BucketCheckpoints, func(data []byte) error {
+			checkpoint := &RepositoryCheckpoint{}
+			if err := json.Unmarshal(data, checkpoint); err != nil {
+				return err
+			}
+			s.Checkpoints[checkpoint.CloneUrl] = checkpoint
+			return nil
+		})
+	})
+	if err != nil {
+		return errors.New(fmt.Sprintf("Database %s is corrupt or generated by an old version of Gitrob: %s", path, err))
+	}
+
+	if found {
+		if *s.Options.Load != "" {
+			return errors.New(fmt.Sprintf("Database %s already contains a session, %s can not be imported into it.", path, *s.Options.Load))
+		}
+		s.Stats = stored.Stats
+		s.Logins = stored.Logins
+		s.IsLocalSession = stored.IsLocalSession
+		return nil
+	}
+	return s.importToStore()
+}
+
+// moves the targets, repositories and findings of a session loaded from a file into the database
+func (s *Session) importToStore() error {
+	err := s.Store.Update(func(tx store.Tx) error {
+		for _, target := range s.Targets {
+			if err := tx.Append(store.BucketTargets, target); err != nil {
+				return err
+			}
+		}
+		for _, repository := range s.Repositories {
+			if err := tx.Append(store.BucketRepositories, repository); err != nil {
+				return err
+			}
+		}
+		for _, finding := range s.Findings {
+			if err := tx.Append(store.BucketFindings, finding); err != nil {
+				return err
+			}
+		}
+		for _, group := range s.FindingGroups {
+			if err := tx.Put(store.BucketFindingGroups, group.Fingerprint, group); err != nil {
+				return err
+			}
+		}
+		for _, finding := range s.Suppressed {
+			if err := tx.Append(store.BucketSuppressed, finding); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	s.Findings = nil
+	s.FindingGroups = nil
+	s.Suppressed = nil
+	if s.Stats == nil {
+		//a new session, whose stats are written with its first checkpoint
+		return nil
+	}
+	return s.saveToStore()
+}
+
+// writes the session state that is not written as it changes, i.e. stats and checkpoints
+func (s *Session) saveToStore() error {
+	return s.Store.Update(func(tx store.Tx) error {
+		stored := storedSession{
+			Version:        s.Version,
+			Stats:          s.Stats,
+			Logins:         s.Logins,
+			IsLocalSession: s.IsLocalSession,
+		}
+		if err := tx.Put(store.BucketSession, storedSessionKey, stored); err != nil {
+			return err
+		}
+		for cloneUrl, checkpoint := range s.Checkpoints {
+			if err := tx.Put(store.BucketCheckpoints, cloneUrl, checkpoint); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (s *Session) appendToStore(bucket string, value interface{}) {
+	if s.Store == nil {
+		return
+	}
+	err := s.Store.Update(func(tx store.Tx) error {
+		return tx.Append(bucket, value)
+	})
+	if err != nil {
+		s.Out.Error("Error writing to database: %s\n", err)
+	}
+}
+
+// records the finding in the group of its fingerprint, returning the group and whether the group is new,
+// or a nil group when the finding was recorded before
+func (s *Session) addFindingToStore(finding *matching.Finding) (*matching.FindingGroup, bool, error) {
+	var group *matching.FindingGroup
+	var isNew bool
+	//findings of concurrently analyzed repositories are written in a single transaction
+	err := s.Store.Batch(func(tx store.Tx) error {
+		group = &matching.FindingGroup{}
+		isNew = false
+		found, err := tx.Get(store.BucketFindingGroups, finding.Fingerprint, group)
+		if err != nil {
+			return err
+		}
+		if found && group.Contains(finding.Id) {
+			group = nil
+			return nil
+		}
+		if found {
+			group.Add(finding)
+		} else {
+			group = matching.NewFindingGroup(finding)
+			isNew = true
+		}
+		if err := tx.Append(store.BucketFindings, finding); err != nil {
+			return err
+		}
+		return tx.Put(store.BucketFindingGroups, finding.Fingerprint, group)
+	})
+	return group, isNew, err
+}
+
+func (s *Session) GetFindings() ([]*matching.Finding, error) {
+	return s.GetFindingsPage(0, 0)
+}
+
+// returns at most limit findings from the offset on, in the order they were recorded, or all of them
+// when limit is not positive
+func (s *Session) GetFindingsPage(offset int, limit int) ([]*matching.Finding, error) {
+	if s.Store == nil {
+		s.Lock()
+		defer s.Unlock()
+		start, end := pageBounds(len(s.Findings), offset, limit)
+		return append([]*matching.Finding{}, s.Findings[start:end]...), nil
+	}
+	return s.findingsPageFromStore(store.BucketFindings, offset, limit)
+}
+
+func (s *Session) GetFindingGroups() ([]*matching.FindingGroup, error) {
+	return s.GetFindingGroupsPage(0, 0)
+}
+
+// returns at most limit finding groups from the offset on, or all of them when limit is not positive,
+// where the groups of a database are ordered by fingerprint
+func (s *Session) GetFindingGroupsPage(offset int, limit int) ([]*matching.FindingGroup, error) {
+	if s.Store == nil {
+		s.Lock()
+		defer s.Unlock()
+		start, end := pageBounds(len(s.FindingGroups), offset, limit)
+		return append([]*matching.FindingGroup{}, s.FindingGroups[start:end]...), nil
+	}
+	groups := []*matching.FindingGroup{}
+	err := s.Store.View(func(tx store.Tx) error {
+		return tx.Page(store.BucketFindingGroups, offset, limit, func(data []byte) error {
+			group := &matching.FindingGroup{}
+			groups = append(groups, group)
+			return json.Unmarshal(data, group)
+		})
+	})
+	return groups, err
+}
+
+func (s *Session) findingsPageFromStore(bucket string, offset int, limit int) ([]*matching.Finding, error) {
+	findings := []*matching.Finding{}
+	err := s.Store.View(func(tx store.Tx) error {
+		return tx.Page(bucket, offset, limit, func(data []byte) error {
+			finding := &matching.Finding{}
+			findings = append(findings, finding)
+			return json.Unmarshal(data, finding)
+		})
+	})
+	return findings, err
+}
+
+// returns the bounds of the page of a list of the given length starting at offset, holding at most limit
+// entries or every entry from the offset on when limit is not positive
+func pageBounds(length int, offset int, limit int) (int, int) {
+	if offset < 0 {
+		offset = 0
+	}
+	if offset > length {
+		offset = length
+	}
+	if limit <= 0 || offset+limit > length {
+		return offset, length
+	}
+	return offset, offset + limit
+}
@@ -0,0 +1,186 @@
+package core
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/codeEmitter/gitrob/common"
+	"github.com/codeEmitter/gitrob/matching"
+	"github.com/codeEmitter/gitrob/store"
+)
+
+// the secrets found by a session compared to those of a baseline session, keyed by fingerprint
+type SessionDiff struct {
+	Baseline  string
+	New       []*matching.FindingGroup
+	Resolved  []*matching.FindingGroup
+	Unchanged []*matching.FindingGroup
+}
+
+// reads the finding groups of a session saved with -save or kept in a database with -db
+func LoadBaseline(location string) ([]*matching.FindingGroup, error) {
+	if !common.FileExists(location) {
+		return nil, errors.New(fmt.Sprintf("Baseline session %s does not exist or is not readable.", location))
+	}
+	isSessionFile, err := isSessionFile(location)
+	if err != nil {
+		return nil, err
+	}
+	if !isSessionFile {
+		return loadBaselineFromStore(location)
+	}
+	data, err := ioutil.ReadFile(location)
+	if err != nil {
+		return nil, err
+	}
+	var baseline struct {
+		Findings      []*matching.Finding
+		FindingGroups []*matching.FindingGroup
+	}
+	if err := json.Unmarshal(data, &baseline); err != nil {
+		return nil, errors.New(fmt.Sprintf("Baseline session file %s is corrupt or generated by an old version of Gitrob.", location))
+	}
+	if len(baseline.Findings) > 0 && len(baseline.FindingGroups) == 0 {
+		return nil, errors.New(fmt.Sprintf("Baseline session file %s has no finding fingerprints, it was generated by an old version of Gitrob.", location))
+	}
+	return baseline.FindingGroups, nil
+}
+
+// tells session files, which are JSON objects, apart from session databases
+func isSessionFile(location string) (bool, error) {
+	file, err := os.Open(location)
+	if err != nil {
+		return false, err
+	}
+	defer file.Close()
+	head := make([]byte, 512)
+	n, err := file.Read(head)
+	if err != nil && err != io.EOF {
+		return false, err
+	}
+	head = bytes.TrimLeft(head[:n], " \t\r\n")
+	return len(head) > 0 && head[0] == '{', nil
+}
+
+func loadBaselineFromStore(location string) ([]*matching.FindingGroup, error) {
+	st, err := store.OpenReadOnly(location)
+	if err != nil {
+		return nil, err
+	}
+	defer st.Close()
+	groups := []*matching.FindingGroup{}
+	err = st.View(func(tx store.Tx) error {
+		return tx.ForEach(store.BucketFindingGroups, func(data []byte) error {
+			group := &matching.FindingGroup{}
+			groups = append(groups, group)
+			return json.Unmarshal(data, group)
+		})
+	})
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("Baseline database %s is corrupt or generated by an old version of Gitrob: %s", location, err))
+	}
+	return groups, nil
+}
+
+func DiffFindingGroups(baseline []*matching.FindingGroup, current []*matching.FindingGroup) SessionDiff {
+	diff := SessionDiff{
+		New:       []*matching.FindingGroup{},
+		Resolved:  []*matching.FindingGroup{},
+		Unchanged: []*matching.FindingGroup{},
+	}
+	baselineFingerprints := make(map[string]bool)
+	for _, group := range baseline {
+		baselineFingerprints[group.Fingerprint] = true
+	}
+	currentFingerprints := make(map[string]bool)
+	for _, group := range current {
+		currentFingerprints[group.Fingerprint] = true
+		if baselineFingerprints[group.Fingerprint] {
+			diff.Unchanged = append(diff.Unchanged, group)
+		} else {
+			diff.New = append(diff.New, group)
+		}
+	}
+	for _, group := range baseline {
+		if !currentFingerprints[group.Fingerprint] {
+			diff.Resolved = append(diff.Resolved, group)
+		}
+	}
+	return diff
+}
+
+func (s *Session) InitBaseline() {
+	if *s.Options.Baseline == "" {
+		return
+	}
+	baseline, err := LoadBaseline(*s.Options.Baseline)
+	if err != nil {
+		s.Out.Fatal("%s\n", err)
+	}
+	s.Baseline = baseline
+}
+
+func (s *Session) Diff() (SessionDiff, error) {
+	current, err := s.GetFindingGroups()
+	if err != nil {
+		return SessionDiff{}, err
+	}
+	diff := DiffFindingGroups(s.Baseline, current)
+	diff.Baseline = *s.Options.Baseline
+	return diff, nil
+}
+
+// returns the fingerprints of the baseline session, or nil without a baseline
+func (s *Session) BaselineFingerprints() []string {
+	if *s.Options.Baseline == "" {
+		return nil
+	}
+	fingerprints := []string{}
+	for _, group := range s.Baseline {
+		fingerprints = append(fingerprints, group.Fingerprint)
+	}
+	return fingerprints
+}
+
+// counts the new secrets at or above the given severity
+func (d SessionDiff) CountNewAtOrAbove(severity string) int {
+	count := 0
+	for _, group := range d.New {
+		if matching.SeverityRank(group.Severity) >= matching.SeverityRank(severity) {
+			count++
+		}
+	}
+	return count
+}
+
+func PrintSessionDiff(sess *Session, diff SessionDiff) {
+	sess.Out.Important("Compared to %s:\n", diff.Baseline)
+	for _, group := range diff.New {
+		sess.Out.Warn(" NEW: %s\n", describeFindingGroup(group))
+	}
+	for _, group := range diff.Resolved {
+		sess.Out.Info(" RESOLVED: %s\n", describeFindingGroup(group))
+	}
+	sess.Out.Info("\nNew.........: %d\n", len(diff.New))
+	sess.Out.Info("Resolved....: %d\n", len(diff.Resolved))
+	sess.Out.Info("Unchanged...: %d\n", len(diff.Unchanged))
+}
+
+func describeFindingGroup(group *matching.FindingGroup) string {
+	description := group.ContentSignatureDescription
+	if description == NoSignatureName {
+		description = group.FileSignatureDescription
+	}
+	if group.FilePath != "" {
+		description = fmt.Sprintf("%s in %s/%s/%s", description, group.RepositoryOwner, group.RepositoryName, group.FilePath)
+	}
+	if group.Secret != "" {
+		description = fmt.Sprintf("%s (%s)", description, common.TruncateString(group.Secret, 40))
+	}
+	return description
+}
@@ -2,40 +2,143 @@ package core
 
 import (
 	"flag"
+	"strings"
+
+	"github.com/codeEmitter/gitrob/matching"
 )
 
 type Options struct {
+	AllRefs           *bool
+	Allowlist         *string `json:"-"`
+	Baseline          *string `json:"-"`
 	BindAddress       *string `json:"-"`
 	CommitDepth       *int
+	Database          *string `json:"-"`
 	Debug             *bool   `json:"-"`
+	ExcludeRepos      *string
+	FailSeverity      *string `json:"-"`
+	FingerprintPath   *bool
 	GitLabAccessToken *string `json:"-"`
+	GitLabApiUrl      *string `json:"-"`
+	GitLabRawUrl      *string `json:"-"`
+	GitLabWebUrl      *string `json:"-"`
 	GithubAccessToken *string `json:"-"`
+	GithubApiUrl      *string `json:"-"`
+	GithubRawUrl      *string `json:"-"`
+	GithubWebUrl      *string `json:"-"`
+	Headless          *bool   `json:"-"`
 	InMemClone        *bool
+	IncludeForks      *bool
+	IncludeRepos      *string
 	Load              *string `json:"-"`
+	Local             *bool
 	Logins            []string
+	MaxRepoSize       *int
 	Mode              *int
 	NoExpandOrgs      *bool
+	NoExpandSubgroups *bool
+	NoRedact          *bool
 	Port              *int
+	PullRequests      *bool
+	PushedSince       *string
+	RedactPrefix      *int
+	RedactSuffix      *int
+	Report            *string `json:"-"`
+	ReportFormat      *string `json:"-"`
+	Resume            *string `json:"-"`
 	Save              *string `json:"-"`
-	Silent            *bool   `json:"-"`
+	ScanRemovedLines  *bool
+	ShowSuppressed    *bool `json:"-"`
+	Silent            *bool `json:"-"`
+	SkipArchived      *bool
+	SkipRules         *string `json:"-"`
+	SSH               *bool
+	SSHKey            *string
+	SSHKnownHosts     *string
 	Threads           *int
+	Visibility        *string
+
+	//files of access tokens to rotate through, one per line
+	GitLabAccessTokenFile *string `json:"-"`
+	GithubAccessTokenFile *string `json:"-"`
+
+	//a GitHub App installation to authenticate as
+	GithubAppId             *int    `json:"-"`
+	GithubAppInstallationId *int    `json:"-"`
+	GithubAppPrivateKey     *string `json:"-"`
+
+	//Bitbucket Server
+	BitbucketAccessToken     *string `json:"-"`
+	BitbucketAccessTokenFile *string `json:"-"`
+	BitbucketApiUrl          *string `json:"-"`
+	BitbucketWebUrl          *string `json:"-"`
 }
 
 func ParseOptions() (Options, error) {
 	options := Options{
+		AllRefs:           flag.Bool("all-refs", false, "Scan the history of every branch and tag instead of only the default branch"),
+		Allowlist:         flag.String("allowlist", "", "File of accepted findings to suppress (default "+strings.Join(matching.DefaultAllowlistFiles, " or ")+" in the working directory, if either exists)"),
+		Baseline:          flag.String("baseline", "", "Compare findings to those of a session saved with -save or kept in a -db database, reporting new and resolved ones"),
 		BindAddress:       flag.String("bind-address", "127.0.0.1", "Address to bind web server to"),
 		CommitDepth:       flag.Int("commit-depth", 500, "Number of repository commits to process"),
+		Database:          flag.String("db", "", "Record the session in a database file as analysis goes, continuing the session it contains if it exists"),
 		Debug:             flag.Bool("debug", false, "Print debugging information"),
-		GitLabAccessToken: flag.String("gitlab-access-token", "", "GitLab access token to use for API requests"),
-		GithubAccessToken: flag.String("github-access-token", "", "GitHub access token to use for API requests"),
+		ExcludeRepos:      flag.String("exclude-repos", "", "Regular expression of repository names or full names not to analyze"),
+		FailSeverity:      flag.String("fail-severity", matching.SeverityLow, "Minimum severity of findings that make a headless scan exit with a non-zero code (low, medium, high or critical)"),
+		FingerprintPath:   flag.Bool("fingerprint-path", false, "Treat the same secret committed to different file paths as different findings"),
+		GitLabAccessToken: flag.String("gitlab-access-token", "", "GitLab access token to use for API requests, or a comma separated list of tokens to rotate through"),
+		GitLabApiUrl:      flag.String("gitlab-api-url", DefaultGitLabApiUrl, "Base URL of the GitLab API"),
+		GitLabRawUrl:      flag.String("gitlab-raw-url", DefaultGitLabRawUrl, "Base URL for raw GitLab file contents"),
+		GitLabWebUrl:      flag.String("gitlab-web-url", DefaultGitLabWebUrl, "Base URL of the GitLab web interface"),
+		GithubAccessToken: flag.String("github-access-token", "", "GitHub access token to use for API requests, or a comma separated list of tokens to rotate through"),
+		GithubApiUrl:      flag.String("github-api-url", DefaultGithubApiUrl, "Base URL of the GitHub API"),
+		GithubRawUrl:      flag.String("github-raw-url", DefaultGithubRawUrl, "Base URL for raw GitHub file contents"),
+		GithubWebUrl:      flag.String("github-web-url", DefaultGithubWebUrl, "Base URL of the GitHub web interface"),
+		Headless:          flag.Bool("headless", false, "Don't start the web interface, write findings to -report and exit once analysis completes with a non-zero code if findings at or above -fail-severity exist"),
 		InMemClone:        flag.Bool("in-mem-clone", false, "Clone repositories into memory"),
+		IncludeForks:      flag.Bool("include-forks", false, "Also analyze forks, leaving out the commits they share with the repository they were forked from"),
+		IncludeRepos:      flag.String("include-repos", "", "Regular expression of repository names or full names to analyze, excluding others"),
 		Load:              flag.String("load", "", "Load session file"),
+		Local:             flag.Bool("local", false, "Treat targets as paths to local git repositories or directories containing them"),
+		MaxRepoSize:       flag.Int("max-repo-size", 0, "Don't analyze repositories larger than this size in kilobytes, as reported by the API (0 for no limit)"),
 		Mode:              flag.Int("mode", 1, "Secrets matching mode (see documentation)."),
 		NoExpandOrgs:      flag.Bool("no-expand-orgs", false, "Don't add members to targets when processing organizations"),
+		NoExpandSubgroups: flag.Bool("no-expand-subgroups", false, "Don't add the subgroups of GitLab groups to targets"),
+		NoRedact:          flag.Bool("no-redact", false, "Record matched secrets without redaction"),
 		Port:              flag.Int("port", 9393, "Port to run web server on"),
+		PullRequests:      flag.Bool("pull-requests", false, "Also scan pull request (GitHub, Bitbucket) and merge request (GitLab) refs, including closed and unmerged ones"),
+		PushedSince:       flag.String("pushed-since", "", "Only analyze repositories pushed to since a date (2020-01-31) or a number of days (90d)"),
+		RedactPrefix:      flag.Int("redact-prefix", 4, "Number of leading characters of a matched secret to leave unredacted"),
+		RedactSuffix:      flag.Int("redact-suffix", 4, "Number of trailing characters of a matched secret to leave unredacted"),
+		Report:            flag.String("report", "", "Write findings as a report to the given path"),
+		ReportFormat:      flag.String("report-format", ReportFormatSarif, "Format of the report written with -report (sarif, json or diff)"),
+		Resume:            flag.String("resume", "", "Resume an interrupted session from its session file, checkpointing to it as analysis continues"),
 		Save:              flag.String("save", "", "Save session to file"),
+		ScanRemovedLines:  flag.Bool("scan-removed-lines", false, "Also match content signatures against lines removed by a commit"),
+		ShowSuppressed:    flag.Bool("show-suppressed", false, "Include findings suppressed by the allowlist in the web interface and reports"),
 		Silent:            flag.Bool("silent", false, "Suppress all output except for errors"),
+		SkipArchived:      flag.Bool("skip-archived", false, "Don't analyze archived repositories"),
+		SkipRules:         flag.String("skip-rules", "", "JSON file of rules for files to skip, replacing the default rules it sets (see documentation)"),
+		SSH:               flag.Bool("ssh", false, "Clone repositories over SSH instead of HTTPS"),
+		SSHKey:            flag.String("ssh-key", "", "Private key file for SSH clones, whose passphrase is read from "+SSHKeyPassphraseEnvVariable+" (default the keys of the SSH agent)"),
+		SSHKnownHosts:     flag.String("ssh-known-hosts", "", "known_hosts file to check SSH host keys against (default the known_hosts files of ssh)"),
 		Threads:           flag.Int("threads", 0, "Number of concurrent threads (default number of logical CPUs)"),
+		Visibility:        flag.String("visibility", "", "Only analyze repositories of a visibility (public, private or internal)"),
+
+		//files of access tokens to rotate through, one per line
+		GitLabAccessTokenFile: flag.String("gitlab-access-token-file", "", "File of GitLab access tokens to rotate through, one per line"),
+		GithubAccessTokenFile: flag.String("github-access-token-file", "", "File of GitHub access tokens to rotate through, one per line"),
+
+		//a GitHub App installation to authenticate as
+		GithubAppId:             flag.Int("github-app-id", 0, "ID of a GitHub App to authenticate as, in place of an access token"),
+		GithubAppInstallationId: flag.Int("github-app-installation-id", 0, "ID of the GitHub App installation to authenticate as (default the installation on the first target)"),
+		GithubAppPrivateKey:     flag.String("github-app-private-key", "", "Private key file of the GitHub App"),
+
+		//Bitbucket Server
+		BitbucketAccessToken:     flag.String("bitbucket-access-token", "", "Bitbucket Server access token to use for API requests, or a comma separated list of tokens to rotate through"),
+		BitbucketAccessTokenFile: flag.String("bitbucket-access-token-file", "", "File of Bitbucket Server access tokens to rotate through, one per line"),
+		BitbucketApiUrl:          flag.String("bitbucket-api-url", "", "Base URL of the Bitbucket Server API, such as https://bitbucket.example.com/rest/api/1.0"),
+		BitbucketWebUrl:          flag.String("bitbucket-web-url", "", "Base URL of the Bitbucket Server web interface (default the API URL without /rest/api/1.0)"),
 	}
 
 	flag.Parse()
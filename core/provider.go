@@ -0,0 +1,135 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/codeEmitter/gitrob/bitbucket"
+	"github.com/codeEmitter/gitrob/common"
+	"github.com/codeEmitter/gitrob/github"
+	"github.com/codeEmitter/gitrob/gitlab"
+	"gopkg.in/src-d/go-git.v4/plumbing/transport"
+	"gopkg.in/src-d/go-git.v4/plumbing/transport/http"
+)
+
+// prefixes that tell which code host a target belongs to when a session scans several, such as github:acme
+var targetPrefixes = map[string]string{
+	"github":    common.SourceTypeGithub,
+	"gitlab":    common.SourceTypeGitLab,
+	"bitbucket": common.SourceTypeBitbucket,
+}
+
+// the usernames code hosts accept access tokens as when cloning over HTTPS
+var tokenUsernames = map[string]string{
+	common.SourceTypeGithub: "x-access-token",
+	common.SourceTypeGitLab: "oauth2",
+}
+
+// the refs pull and merge requests are fetched from and to, which clones leave out
+var pullRequestRefSpecs = map[string]string{
+	common.SourceTypeGithub:    github.PullRequestRefSpec,
+	common.SourceTypeGitLab:    gitlab.MergeRequestRefSpec,
+	common.SourceTypeBitbucket: bitbucket.PullRequestRefSpec,
+}
+
+// a code host the session gathers targets and repositories from, with the tokens used to access it
+type Provider struct {
+	Type   string
+	Client common.IClient
+	Tokens *common.TokenPool
+}
+
+// returns the access token currently used for API requests, which clones also use
+func (p *Provider) AccessToken() (string, error) {
+	if p.Tokens == nil || p.Tokens.Len() == 0 {
+		return "", nil
+	}
+	token, _, err := p.Tokens.Token()
+	return token, err
+}
+
+// returns the authentication for fetching from the provider over HTTPS with its current access token, which
+// private repositories require
+func (p *Provider) HttpAuth() (transport.AuthMethod, error) {
+	token, err := p.AccessToken()
+	if err != nil || token == "" {
+		return nil, err
+	}
+	//Bitbucket Server takes access tokens as bearer tokens rather than as the password of a user
+	if p.Type == common.SourceTypeBitbucket {
+		return &http.TokenAuth{Token: token}, nil
+	}
+	return &http.BasicAuth{Username: tokenUsernames[p.Type], Password: token}, nil
+}
+
+// returns the provider of a target given on the command line along with the target without its prefix; targets
+// need no prefix when the session has a single provider
+func (s *Session) TargetProvider(login string) (*Provider, string, error) {
+	//local paths may contain colons, and local sessions have no other provider
+	if !s.IsLocalSession {
+		if i := strings.Index(login, ":"); i > 0 {
+			if sourceType, ok := targetPrefixes[strings.ToLower(login[:i])]; ok {
+				provider, ok := s.Providers[sourceType]
+				if !ok {
+					return nil, "", errors.New(fmt.Sprintf("No %s access token was found for target %s.", sourceType, login))
+				}
+				return provider, login[i+1:], nil
+			}
+		}
+	}
+	if len(s.Providers) != 1 {
+		return nil, "", errors.New(fmt.Sprintf("Target %s is ambiguous when scanning several code hosts, prefix it with github:, gitlab: or bitbucket:.", login))
+	}
+	for _, provider := range s.Providers {
+		return provider, login, nil
+	}
+	return nil, "", nil
+}
+
+// returns the provider targets and repositories of the source type come from, where sessions saved before
+// they recorded it use their only provider
+func (s *Session) Provider(sourceType *string) (*Provider, error) {
+	if sourceType == nil || *sourceType == "" {
+		if len(s.Providers) == 1 {
+			for _, provider := range s.Providers {
+				return provider, nil
+			}
+		}
+		return nil, errors.New("Unknown source of repository.")
+	}
+	provider, ok := s.Providers[*sourceType]
+	if !ok {
+		return nil, errors.New(fmt.Sprintf("No %s access token was found.", *sourceType))
+	}
+	return provider, nil
+}
+
+// returns the web URL findings of the source type link to
+func (s *Session) WebUrl(sourceType string) string {
+	switch sourceType {
+	case common.SourceTypeGithub:
+		return s.Github.WebUrl
+	case common.SourceTypeBitbucket:
+		return s.Bitbucket.WebUrl
+	}
+	return s.GitLab.WebUrl
+}
+
+// returns the URL raw file contents of the source type are fetched from
+func (s *Session) RawUrl(sourceType string) string {
+	switch sourceType {
+	case common.SourceTypeGithub:
+		return s.Github.RawUrl
+	case common.SourceTypeBitbucket:
+		//Bitbucket Server serves raw contents from its web interface
+		return s.Bitbucket.WebUrl
+	}
+	return s.GitLab.RawUrl
+}
+
+// targets and repositories are numbered separately by each code host, and by sessions that didn't record
+// their source
+func sameSourceType(a *string, b *string) bool {
+	return a == nil || b == nil || *a == *b
+}
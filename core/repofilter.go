@@ -0,0 +1,106 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/codeEmitter/gitrob/common"
+)
+
+// decides which gathered repositories are analyzed; attributes a source does not provide, such as the
+// visibility of local repositories, never exclude a repository
+type RepositoryFilter struct {
+	Include      *regexp.Regexp
+	Exclude      *regexp.Regexp
+	Visibility   string
+	SkipArchived bool
+	PushedSince  time.Time
+	MaxSize      int64
+}
+
+func NewRepositoryFilter(options Options) (*RepositoryFilter, error) {
+	filter := &RepositoryFilter{
+		Visibility:   strings.ToLower(*options.Visibility),
+		SkipArchived: *options.SkipArchived,
+		MaxSize:      int64(*options.MaxRepoSize),
+	}
+	var err error
+	if *options.IncludeRepos != "" {
+		if filter.Include, err = regexp.Compile(*options.IncludeRepos); err != nil {
+			return nil, errors.New(fmt.Sprintf("Invalid -include-repos expression: %s", err))
+		}
+	}
+	if *options.ExcludeRepos != "" {
+		if filter.Exclude, err = regexp.Compile(*options.ExcludeRepos); err != nil {
+			return nil, errors.New(fmt.Sprintf("Invalid -exclude-repos expression: %s", err))
+		}
+	}
+	switch filter.Visibility {
+	case "", common.VisibilityPublic, common.VisibilityPrivate, common.VisibilityInternal:
+	default:
+		return nil, errors.New(fmt.Sprintf("Unsupported visibility: %s", *options.Visibility))
+	}
+	if *options.PushedSince != "" {
+		if filter.PushedSince, err = parsePushedSince(*options.PushedSince, time.Now()); err != nil {
+			return nil, err
+		}
+	}
+	return filter, nil
+}
+
+// parses a date, such as 2020-01-31, or a number of days before now, such as 90d
+func parsePushedSince(value string, now time.Time) (time.Time, error) {
+	if strings.HasSuffix(value, "d") {
+		days, err := strconv.Atoi(strings.TrimSuffix(value, "d"))
+		if err == nil && days >= 0 {
+			return now.AddDate(0, 0, -days), nil
+		}
+	}
+	date, err := time.Parse("2006-01-02", value)
+	if err != nil {
+		return time.Time{}, errors.New(fmt.Sprintf("Invalid -pushed-since value %s, expected a date such as 2020-01-31 or a number of days such as 90d.", value))
+	}
+	return date, nil
+}
+
+// returns why the repository is filtered out, or an empty string when it is analyzed
+func (f *RepositoryFilter) Reason(repo *common.Repository) string {
+	names := []string{}
+	for _, name := range []*string{repo.Name, repo.FullName} {
+		if name != nil {
+			names = append(names, *name)
+		}
+	}
+	if f.Include != nil && !matchesAny(f.Include, names) {
+		return "not included by name"
+	}
+	if f.Exclude != nil && matchesAny(f.Exclude, names) {
+		return "excluded by name"
+	}
+	if f.Visibility != "" && repo.Visibility != nil && *repo.Visibility != f.Visibility {
+		return fmt.Sprintf("%s visibility", *repo.Visibility)
+	}
+	if f.SkipArchived && repo.Archived != nil && *repo.Archived {
+		return "archived"
+	}
+	if !f.PushedSince.IsZero() && repo.PushedAt != nil && repo.PushedAt.Before(f.PushedSince) {
+		return fmt.Sprintf("last pushed %s", repo.PushedAt.Format("2006-01-02"))
+	}
+	if f.MaxSize > 0 && repo.Size != nil && *repo.Size > f.MaxSize {
+		return fmt.Sprintf("size of %d KB", *repo.Size)
+	}
+	return ""
+}
+
+func matchesAny(regex *regexp.Regexp, values []string) bool {
+	for _, value := range values {
+		if regex.MatchString(value) {
+			return true
+		}
+	}
+	return false
+}
@@ -1,28 +1,31 @@
 package core
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"strconv"
 	"strings"
 
 	"github.com/codeEmitter/gitrob/common"
+	"github.com/codeEmitter/gitrob/matching"
 	assetfs "github.com/elazarl/go-bindata-assetfs"
 	"github.com/gin-contrib/secure"
 	"github.com/gin-contrib/static"
 	"github.com/gin-gonic/gin"
+	"gopkg.in/src-d/go-git.v4"
+	"gopkg.in/src-d/go-git.v4/plumbing"
 )
 
 const (
-	GithubBaseUri   = "https://raw.githubusercontent.com"
 	MaximumFileSize = 153600
-	GitLabBaseUri   = "https://gitlab.com"
 	CspPolicy       = "default-src 'none'; script-src 'self'; style-src 'self' 'unsafe-inline'; img-src 'self' data:; font-src 'self'"
 	ReferrerPolicy  = "no-referrer"
+	PageSize        = 500
 )
 
-var IsGithub bool
-
 type binaryFileSystem struct {
 	fs http.FileSystem
 }
@@ -50,8 +53,6 @@ func BinaryFileSystem(root string) *binaryFileSystem {
 
 func NewRouter(s *Session) *gin.Engine {
 
-	IsGithub = s.IsGithubSession
-
 	if *s.Options.Debug == true {
 		gin.SetMode(gin.DebugMode)
 	} else {
@@ -70,10 +71,69 @@ func NewRouter(s *Session) *gin.Engine {
 		ReferrerPolicy:        ReferrerPolicy,
 	}))
 	router.GET("/stats", func(c *gin.Context) {
-		c.JSON(200, s.Stats)
+		//analysis goes on updating the stats while they are encoded
+		s.Stats.Lock()
+		data, err := json.Marshal(s.Stats)
+		s.Stats.Unlock()
+		if err != nil {
+			c.String(http.StatusInternalServerError, err.Error())
+			return
+		}
+		c.Data(200, "application/json; charset=utf-8", data)
 	})
 	router.GET("/findings", func(c *gin.Context) {
-		c.JSON(200, s.Findings)
+		offset, limit, err := pageQuery(c)
+		if err != nil {
+			c.String(http.StatusBadRequest, err.Error())
+			return
+		}
+		findings, err := s.GetFindingsPage(offset, limit)
+		if err != nil {
+			c.String(http.StatusInternalServerError, err.Error())
+			return
+		}
+		c.JSON(200, findings)
+	})
+	router.GET("/suppressed", func(c *gin.Context) {
+		offset, limit, err := pageQuery(c)
+		if err != nil {
+			c.String(http.StatusBadRequest, err.Error())
+			return
+		}
+		if !*s.Options.ShowSuppressed {
+			c.JSON(200, []*matching.Finding{})
+			return
+		}
+		findings, err := s.GetSuppressedFindingsPage(offset, limit)
+		if err != nil {
+			c.String(http.StatusInternalServerError, err.Error())
+			return
+		}
+		c.JSON(200, findings)
+	})
+	router.GET("/groups", func(c *gin.Context) {
+		offset, limit, err := pageQuery(c)
+		if err != nil {
+			c.String(http.StatusBadRequest, err.Error())
+			return
+		}
+		groups, err := s.GetFindingGroupsPage(offset, limit)
+		if err != nil {
+			c.String(http.StatusInternalServerError, err.Error())
+			return
+		}
+		c.JSON(200, groups)
+	})
+	router.GET("/baseline", func(c *gin.Context) {
+		c.JSON(200, s.BaselineFingerprints())
+	})
+	router.GET("/diff", func(c *gin.Context) {
+		diff, err := s.Diff()
+		if err != nil {
+			c.String(http.StatusInternalServerError, err.Error())
+			return
+		}
+		c.JSON(200, diff)
 	})
 	router.GET("/targets", func(c *gin.Context) {
 		c.JSON(200, s.Targets)
@@ -81,18 +141,76 @@ func NewRouter(s *Session) *gin.Engine {
 	router.GET("/repositories", func(c *gin.Context) {
 		c.JSON(200, s.Repositories)
 	})
-	router.GET("/files/:owner/:repo/:commit/*path", fetchFile)
+	router.GET("/files/:owner/:repo/:commit/*path", func(c *gin.Context) {
+		sourceType := s.fileSourceType(c.Param("owner"), c.Param("repo"), c.Param("commit"), c.Query("sourceType"))
+		if sourceType == common.SourceTypeLocal {
+			fetchLocalFile(s, c)
+			return
+		}
+		fetchFile(s, c, sourceType)
+	})
 
 	return router
 }
 
-func fetchFile(c *gin.Context) {
+// reads the offset and limit of a page of findings or groups, which holds at most PageSize of them
+func pageQuery(c *gin.Context) (int, int, error) {
+	offset, err := strconv.Atoi(c.DefaultQuery("offset", "0"))
+	if err != nil || offset < 0 {
+		return 0, 0, errors.New(fmt.Sprintf("Invalid offset: %s", c.Query("offset")))
+	}
+	limit, err := strconv.Atoi(c.DefaultQuery("limit", strconv.Itoa(PageSize)))
+	if err != nil || limit <= 0 {
+		return 0, 0, errors.New(fmt.Sprintf("Invalid limit: %s", c.Query("limit")))
+	}
+	if limit > PageSize {
+		limit = PageSize
+	}
+	return offset, limit, nil
+}
+
+// returns the code host of the findings in a commit of a repository, whose files are fetched from it. the web
+// interface names it along with the commit, since findings kept in a database aren't held in memory
+func (s *Session) fileSourceType(owner string, repo string, commit string, requested string) string {
+	if s.IsLocalSession {
+		return common.SourceTypeLocal
+	}
+	switch requested {
+	case common.SourceTypeGithub, common.SourceTypeGitLab, common.SourceTypeBitbucket:
+		return requested
+	}
+	s.Lock()
+	defer s.Unlock()
+	for _, findings := range [][]*matching.Finding{s.Findings, s.Suppressed} {
+		for _, finding := range findings {
+			if finding.RepositoryOwner == owner && finding.RepositoryName == repo && finding.CommitHash == commit {
+				return finding.GetSourceType(s.GitLab.WebUrl)
+			}
+		}
+	}
+	for _, repository := range s.Repositories {
+		if repository.Owner != nil && *repository.Owner == owner && repository.Name != nil && *repository.Name == repo {
+			if repository.SourceType != nil && *repository.SourceType != "" {
+				return *repository.SourceType
+			}
+			if provider, err := s.Provider(repository.SourceType); err == nil {
+				return provider.Type
+			}
+		}
+	}
+	return common.SourceTypeGithub
+}
+
+func fetchFile(s *Session, c *gin.Context, sourceType string) {
 	fileUrl := func() string {
-		if IsGithub {
-			return fmt.Sprintf("%s/%s/%s/%s%s", GithubBaseUri, c.Param("owner"), c.Param("repo"), c.Param("commit"), c.Param("path"))
+		if sourceType == common.SourceTypeGithub {
+			return fmt.Sprintf("%s/%s/%s/%s%s", s.RawUrl(sourceType), c.Param("owner"), c.Param("repo"), c.Param("commit"), c.Param("path"))
+		} else if sourceType == common.SourceTypeBitbucket {
+			repoPath := common.BitbucketRepositoryPath(c.Param("owner"), c.Param("repo"))
+			return fmt.Sprintf("%s/%s/raw%s?at=%s", s.RawUrl(sourceType), repoPath, c.Param("path"), c.Param("commit"))
 		} else {
 			results := common.CleanUrlSpaces(c.Param("owner"), c.Param("repo"), c.Param("commit"), c.Param("path"))
-			return fmt.Sprintf("%s/%s/%s/%s/%s%s", GitLabBaseUri, results[0], results[1], "/-/raw/", results[2], results[3])
+			return fmt.Sprintf("%s/%s/%s/-/raw/%s%s", s.RawUrl(sourceType), results[0], results[1], results[2], results[3])
 		}
 	}()
 	resp, err := http.Head(fileUrl)
@@ -136,3 +254,76 @@ func fetchFile(c *gin.Context) {
 
 	c.String(http.StatusOK, string(body[:]))
 }
+
+// returns the repository a local file is in, identified by the repository ID its finding recorded, or else by
+// its owner and name when no other repository shares them
+func (s *Session) localRepository(owner string, name string, id string) *common.Repository {
+	s.Lock()
+	defer s.Unlock()
+	var repository *common.Repository
+	for _, r := range s.Repositories {
+		if *r.Owner != owner || *r.Name != name {
+			continue
+		}
+		if id != "" {
+			if strconv.FormatInt(*r.ID, 10) == id {
+				return r
+			}
+			continue
+		}
+		if repository != nil {
+			return nil
+		}
+		repository = r
+	}
+	return repository
+}
+
+func fetchLocalFile(s *Session, c *gin.Context) {
+	repository := s.localRepository(c.Param("owner"), c.Param("repo"), c.Query("repository"))
+	if repository == nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"message": "No content",
+		})
+		return
+	}
+
+	clone, err := git.PlainOpen(*repository.CloneURL)
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": err,
+		})
+		return
+	}
+	commit, err := clone.CommitObject(plumbing.NewHash(c.Param("commit")))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"message": "No content",
+		})
+		return
+	}
+	file, err := commit.File(strings.TrimPrefix(c.Param("path"), "/"))
+	if err != nil {
+		c.JSON(http.StatusNotFound, gin.H{
+			"message": "No content",
+		})
+		return
+	}
+
+	if file.Size > MaximumFileSize {
+		c.JSON(http.StatusUnprocessableEntity, gin.H{
+			"message": fmt.Sprintf("File size exceeds maximum of %d bytes", MaximumFileSize),
+		})
+		return
+	}
+
+	contents, err := file.Contents()
+	if err != nil {
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"message": err,
+		})
+		return
+	}
+
+	c.String(http.StatusOK, contents)
+}
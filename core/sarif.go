@@ -0,0 +1,288 @@
+package core
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"strings"
+
+	"github.com/codeEmitter/gitrob/common"
+	"github.com/codeEmitter/gitrob/matching"
+)
+
+const (
+	SarifVersion    = "2.1.0"
+	SarifSchema     = "https://json.schemastore.org/sarif-2.1.0.json"
+	SarifToolUri    = "https://github.com/codeEmitter/gitrob"
+	NoSignatureName = "NA"
+	//the base of the paths results are located at, which is the root of the run's repository
+	SarifRepositoryRoot = "REPOROOT"
+)
+
+var sarifRuleIdRegex = regexp.MustCompile(`[^a-z0-9]+`)
+
+type SarifLog struct {
+	Version string     `json:"version"`
+	Schema  string     `json:"$schema"`
+	Runs    []SarifRun `json:"runs"`
+}
+
+type SarifRun struct {
+	Tool                     SarifTool               `json:"tool"`
+	AutomationDetails        *SarifAutomationDetails `json:"automationDetails,omitempty"`
+	VersionControlProvenance []SarifVersionControl   `json:"versionControlProvenance,omitempty"`
+	Results                  []SarifResult           `json:"results"`
+}
+
+type SarifAutomationDetails struct {
+	Id string `json:"id"`
+}
+
+type SarifVersionControl struct {
+	RepositoryUri string                `json:"repositoryUri"`
+	Branch        string                `json:"branch,omitempty"`
+	MappedTo      SarifArtifactLocation `json:"mappedTo"`
+}
+
+type SarifTool struct {
+	Driver SarifDriver `json:"driver"`
+}
+
+type SarifDriver struct {
+	Name           string      `json:"name"`
+	Version        string      `json:"version"`
+	InformationUri string      `json:"informationUri"`
+	Rules          []SarifRule `json:"rules"`
+}
+
+type SarifRule struct {
+	Id               string        `json:"id"`
+	Name             string        `json:"name"`
+	ShortDescription SarifMessage  `json:"shortDescription"`
+	FullDescription  *SarifMessage `json:"fullDescription,omitempty"`
+}
+
+type SarifMessage struct {
+	Text string `json:"text"`
+}
+
+type SarifResult struct {
+	RuleId              string                 `json:"ruleId"`
+	RuleIndex           int                    `json:"ruleIndex"`
+	Level               string                 `json:"level"`
+	Message             SarifMessage           `json:"message"`
+	Locations           []SarifLocation        `json:"locations"`
+	PartialFingerprints map[string]string      `json:"partialFingerprints"`
+	Suppressions        []SarifSuppression     `json:"suppressions,omitempty"`
+	Properties          map[string]interface{} `json:"properties"`
+}
+
+type SarifSuppression struct {
+	Kind          string `json:"kind"`
+	Justification string `json:"justification,omitempty"`
+}
+
+type SarifLocation struct {
+	PhysicalLocation SarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type SarifPhysicalLocation struct {
+	ArtifactLocation SarifArtifactLocation `json:"artifactLocation"`
+	Region           *SarifRegion          `json:"region,omitempty"`
+}
+
+type SarifArtifactLocation struct {
+	Uri        string                 `json:"uri,omitempty"`
+	UriBaseId  string                 `json:"uriBaseId,omitempty"`
+	Properties map[string]interface{} `json:"properties,omitempty"`
+}
+
+type SarifRegion struct {
+	StartLine int `json:"startLine"`
+}
+
+// builds a SARIF log with one rule per signature and one run per repository, holding a result per finding;
+// results are located relative to the root of their repository and carry the commit they were found in
+func NewSarifLog(signatures matching.Signatures, repositories []*common.Repository, findings []*matching.Finding) SarifLog {
+	driver := SarifDriver{
+		Name:           common.Name,
+		Version:        common.Version,
+		InformationUri: SarifToolUri,
+		Rules:          []SarifRule{},
+	}
+	ruleIndexes := make(map[string]int)
+	addRule := func(kind string, description string, comment string) int {
+		id := sarifRuleId(kind, description)
+		if index, ok := ruleIndexes[id]; ok {
+			return index
+		}
+		rule := SarifRule{Id: id, Name: description, ShortDescription: SarifMessage{Text: description}}
+		if comment != "" {
+			rule.FullDescription = &SarifMessage{Text: comment}
+		}
+		ruleIndexes[id] = len(driver.Rules)
+		driver.Rules = append(driver.Rules, rule)
+		return ruleIndexes[id]
+	}
+	for _, signature := range signatures.FileSignatures {
+		addRule("file", signature.Description, signature.Comment)
+	}
+	for _, signature := range signatures.ContentSignatures {
+		addRule("content", signature.Description, signature.Comment)
+	}
+
+	ruleIds := make([]string, len(findings))
+	ruleIndexesOfFindings := make([]int, len(findings))
+	for i, finding := range findings {
+		//a content match is the more specific of the two signatures a finding can carry
+		if finding.ContentSignatureDescription != NoSignatureName {
+			ruleIndexesOfFindings[i] = addRule("content", finding.ContentSignatureDescription, finding.ContentSignatureComment)
+		} else {
+			ruleIndexesOfFindings[i] = addRule("file", finding.FileSignatureDescription, finding.FileSignatureComment)
+		}
+		ruleIds[i] = driver.Rules[ruleIndexesOfFindings[i]].Id
+	}
+
+	defaultBranches := make(map[string]string)
+	for _, repository := range repositories {
+		if repository.CloneURL != nil && repository.DefaultBranch != nil {
+			defaultBranches[*repository.CloneURL] = *repository.DefaultBranch
+		}
+	}
+	runs := []SarifRun{}
+	runIndexes := make(map[string]int)
+	for i, finding := range findings {
+		index, ok := runIndexes[finding.CloneUrl]
+		if !ok {
+			index = len(runs)
+			runIndexes[finding.CloneUrl] = index
+			runs = append(runs, newSarifRun(driver, finding, defaultBranches[finding.CloneUrl]))
+		}
+		runs[index].Results = append(runs[index].Results, newSarifResult(finding, ruleIds[i], ruleIndexesOfFindings[i]))
+	}
+	if len(runs) == 0 {
+		//a report without findings still tells which tool produced it
+		runs = append(runs, SarifRun{Tool: SarifTool{Driver: driver}, Results: []SarifResult{}})
+	}
+
+	return SarifLog{
+		Version: SarifVersion,
+		Schema:  SarifSchema,
+		Runs:    runs,
+	}
+}
+
+// a run of the analysis of the repository of the finding
+func newSarifRun(driver SarifDriver, finding *matching.Finding, branch string) SarifRun {
+	repositoryUri := finding.RepositoryUrl
+	if repositoryUri == "" {
+		repositoryUri = finding.CloneUrl
+	}
+	return SarifRun{
+		Tool: SarifTool{Driver: driver},
+		//code scanning dashboards tell the runs of one tool apart by their automation ID
+		AutomationDetails: &SarifAutomationDetails{
+			Id: fmt.Sprintf("%s/%s/%s/", common.Name, finding.RepositoryOwner, finding.RepositoryName),
+		},
+		VersionControlProvenance: []SarifVersionControl{{
+			RepositoryUri: repositoryUri,
+			Branch:        branch,
+			MappedTo:      SarifArtifactLocation{UriBaseId: SarifRepositoryRoot},
+		}},
+		Results: []SarifResult{},
+	}
+}
+
+func newSarifResult(finding *matching.Finding, ruleId string, ruleIndex int) SarifResult {
+	location := SarifPhysicalLocation{ArtifactLocation: SarifArtifactLocation{
+		Uri:        finding.FilePath,
+		UriBaseId:  SarifRepositoryRoot,
+		Properties: map[string]interface{}{"commitHash": finding.CommitHash},
+	}}
+	//removed lines do not exist in the file as of the finding's commit
+	if finding.LineNumber > 0 && !finding.Removed {
+		location.Region = &SarifRegion{StartLine: finding.LineNumber}
+	}
+	message := fmt.Sprintf("%s in %s/%s at commit %s", sarifRuleName(finding), finding.RepositoryOwner,
+		finding.RepositoryName, finding.CommitHash)
+	if finding.Removed {
+		message = fmt.Sprintf("%s removed from %s/%s in commit %s", sarifRuleName(finding), finding.RepositoryOwner,
+			finding.RepositoryName, finding.CommitHash)
+	}
+	properties := map[string]interface{}{
+		"repositoryOwner": finding.RepositoryOwner,
+		"repositoryName":  finding.RepositoryName,
+		"repositoryUrl":   finding.RepositoryUrl,
+		"commitHash":      finding.CommitHash,
+		"commitUrl":       finding.CommitUrl,
+		"commitAuthor":    finding.CommitAuthor,
+		"fileUrl":         finding.FileUrl,
+		"severity":        finding.Severity,
+	}
+	if finding.Secret != "" {
+		properties["secret"] = finding.Secret
+	}
+	if len(finding.Refs) > 0 {
+		properties["refs"] = finding.Refs
+	}
+	result := SarifResult{
+		RuleId:    ruleId,
+		RuleIndex: ruleIndex,
+		Level:     sarifLevel(finding.Severity),
+		Message:   SarifMessage{Text: message},
+		Locations: []SarifLocation{{PhysicalLocation: location}},
+		PartialFingerprints: map[string]string{
+			//v1 fingerprints identified every private key by its header
+			"gitrobFingerprint/v2": finding.Fingerprint,
+			"gitrobFindingId/v1":   finding.Id,
+		},
+		Properties: properties,
+	}
+	if finding.SuppressedBy != "" {
+		//the allowlist is kept apart from the scanned repositories
+		result.Suppressions = []SarifSuppression{{Kind: "external", Justification: "Allowlisted by " + finding.SuppressedBy}}
+	}
+	return result
+}
+
+func sarifLevel(severity string) string {
+	switch {
+	case matching.SeverityRank(severity) >= matching.SeverityRank(matching.SeverityHigh):
+		return "error"
+	case matching.SeverityRank(severity) >= matching.SeverityRank(matching.SeverityMedium):
+		return "warning"
+	default:
+		return "note"
+	}
+}
+
+func sarifRuleName(finding *matching.Finding) string {
+	if finding.ContentSignatureDescription != NoSignatureName {
+		return finding.ContentSignatureDescription
+	}
+	return finding.FileSignatureDescription
+}
+
+func sarifRuleId(kind string, description string) string {
+	return kind + "/" + strings.Trim(sarifRuleIdRegex.ReplaceAllString(strings.ToLower(description), "-"), "-")
+}
+
+func (s *Session) SaveSarifToFile(location string) error {
+	findings, err := s.GetVisibleFindings()
+	if err != nil {
+		return err
+	}
+	s.Lock()
+	repositories := append([]*common.Repository{}, s.Repositories...)
+	s.Unlock()
+	sarifJson, err := json.MarshalIndent(NewSarifLog(s.Signatures, repositories, findings), "", "  ")
+	if err != nil {
+		return err
+	}
+	err = ioutil.WriteFile(location, sarifJson, 0644)
+	if err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,21 @@
+package core
+
+import "testing"
+
+func TestSarifResultsOfDifferentKeysHaveDifferentFingerprints(t *testing.T) {
+	sess := newTestSession(t)
+	analyzeAddedFile(t, sess, "alpha", "deploy/id_ed25519", joinLines(firstPrivateKey))
+	analyzeAddedFile(t, sess, "alpha", "deploy/id_ed25519", joinLines(secondPrivateKey))
+	findings, err := sess.GetFindings()
+	if err != nil {
+		t.Fatal(err)
+	}
+	log := NewSarifLog(sess.Signatures, nil, findings)
+	if len(log.Runs) != 1 || len(log.Runs[0].Results) != 2 {
+		t.Fatalf("SARIF log has %d runs, want 1 run of 2 results", len(log.Runs))
+	}
+	a, b := log.Runs[0].Results[0].PartialFingerprints, log.Runs[0].Results[1].PartialFingerprints
+	if a["gitrobFingerprint/v2"] == "" || a["gitrobFingerprint/v2"] == b["gitrobFingerprint/v2"] {
+		t.Errorf("results of different keys have fingerprints %q and %q", a["gitrobFingerprint/v2"], b["gitrobFingerprint/v2"])
+	}
+}
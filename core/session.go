@@ -5,26 +5,44 @@ import (
 	"errors"
 	"fmt"
 	"github.com/codeEmitter/gitrob/matching"
+	"github.com/codeEmitter/gitrob/store"
 	"io/ioutil"
+	"net/http"
 	"os"
 	"runtime"
 	"strings"
 	"sync"
 	"time"
 
+	bb "github.com/codeEmitter/gitrob/bitbucket"
 	"github.com/codeEmitter/gitrob/common"
 	gh "github.com/codeEmitter/gitrob/github"
 	gl "github.com/codeEmitter/gitrob/gitlab"
+	"github.com/codeEmitter/gitrob/local"
 	"github.com/gin-gonic/gin"
+	"gopkg.in/src-d/go-git.v4/plumbing/transport"
 )
 
 const (
-	GitHubAccessTokenEnvVariable = "GITROB_GITHUB_ACCESS_TOKEN"
-	GitLabAccessTokenEnvVariable = "GITROB_GITLAB_ACCESS_TOKEN"
-	StatusInitializing           = "initializing"
-	StatusGathering              = "gathering"
-	StatusAnalyzing              = "analyzing"
-	StatusFinished               = "finished"
+	GitHubAccessTokenEnvVariable    = "GITROB_GITHUB_ACCESS_TOKEN"
+	GitLabAccessTokenEnvVariable    = "GITROB_GITLAB_ACCESS_TOKEN"
+	BitbucketAccessTokenEnvVariable = "GITROB_BITBUCKET_ACCESS_TOKEN"
+	SSHKeyPassphraseEnvVariable     = "GITROB_SSH_KEY_PASSPHRASE"
+	DefaultGithubApiUrl             = "https://api.github.com/"
+	DefaultGithubWebUrl             = "https://github.com"
+	DefaultGithubRawUrl             = "https://raw.githubusercontent.com"
+	DefaultGitLabApiUrl             = "https://gitlab.com/api/v4/"
+	DefaultGitLabWebUrl             = "https://gitlab.com"
+	DefaultGitLabRawUrl             = "https://gitlab.com"
+	BitbucketApiPath                = "/rest/api/1.0"
+	StatusInitializing              = "initializing"
+	StatusGathering                 = "gathering"
+	StatusAnalyzing                 = "analyzing"
+	StatusFinished                  = "finished"
+	ReportFormatSarif               = "sarif"
+	ReportFormatJson                = "json"
+	ReportFormatDiff                = "diff"
+	ExitCodeFindings                = 2
 )
 
 type Stats struct {
@@ -39,32 +57,97 @@ type Stats struct {
 	Commits      int
 	Files        int
 	Findings     int
+	Suppressed   int
+	Skipped      SkippedFiles
+	RateLimits   map[string]*common.RateLimit `json:",omitempty"`
+}
+
+// the number of files skipped for each skip reason
+type SkippedFiles struct {
+	Extension int
+	Path      int
+	Pattern   int
+	Size      int
+	Binary    int
+}
+
+func (s SkippedFiles) Total() int {
+	return s.Extension + s.Path + s.Pattern + s.Size + s.Binary
+}
+
+func (s SkippedFiles) String() string {
+	counts := []string{}
+	for _, count := range []struct {
+		reason string
+		count  int
+	}{
+		{matching.SkipReasonExtension, s.Extension},
+		{matching.SkipReasonPath, s.Path},
+		{matching.SkipReasonPattern, s.Pattern},
+		{matching.SkipReasonSize, s.Size},
+		{matching.SkipReasonBinary, s.Binary},
+	} {
+		if count.count > 0 {
+			counts = append(counts, fmt.Sprintf("%s: %d", count.reason, count.count))
+		}
+	}
+	return fmt.Sprintf("%d (%s)", s.Total(), strings.Join(counts, ", "))
 }
 
 type Github struct {
-	AccessToken string `json:"-"`
+	AccessToken  string   `json:"-"`
+	AccessTokens []string `json:"-"`
+	ApiUrl       string
+	WebUrl       string
+	RawUrl       string
 }
 
 type GitLab struct {
-	AccessToken string `json:"-"`
+	AccessToken  string   `json:"-"`
+	AccessTokens []string `json:"-"`
+	ApiUrl       string
+	WebUrl       string
+	RawUrl       string
+}
+
+type Bitbucket struct {
+	AccessToken  string   `json:"-"`
+	AccessTokens []string `json:"-"`
+	ApiUrl       string
+	WebUrl       string
 }
 
 type Session struct {
 	sync.Mutex
 
-	Version         string
-	Options         Options        `json:"-"` //do not unmarshal to json on save
-	Out             *common.Logger `json:"-"` //do not unmarshal to json on save
-	Stats           *Stats
-	Github          Github         `json:"-"` //do not unmarshal to json on save
-	GitLab          GitLab         `json:"-"` //do not unmarshal to json on save
-	Client          common.IClient `json:"-"` //do not unmarshal to json on save
-	Router          *gin.Engine    `json:"-"` //do not unmarshal to json on save
-	Targets         []*common.Owner
-	Repositories    []*common.Repository
-	Findings        []*matching.Finding
-	IsGithubSession bool                `json:"-"` //do not unmarshal to json on save
-	Signatures      matching.Signatures `json:"-"` //do not unmarshal to json on save
+	Version        string
+	Options        Options        `json:"-"` //do not unmarshal to json on save
+	Out            *common.Logger `json:"-"` //do not unmarshal to json on save
+	Stats          *Stats
+	Github         Github      `json:"-"` //do not unmarshal to json on save
+	GitLab         GitLab      `json:"-"` //do not unmarshal to json on save
+	Bitbucket      Bitbucket   `json:"-"` //do not unmarshal to json on save
+	Router         *gin.Engine `json:"-"` //do not unmarshal to json on save
+	Targets        []*common.Owner
+	Repositories   []*common.Repository
+	Findings       []*matching.Finding
+	FindingGroups  []*matching.FindingGroup
+	Suppressed     []*matching.Finding
+	Logins         []string
+	Checkpoints    map[string]*RepositoryCheckpoint
+	Store          *store.Store             `json:"-"` //do not unmarshal to json on save
+	Baseline       []*matching.FindingGroup `json:"-"` //do not unmarshal to json on save
+	Allowlist      *matching.Allowlist      `json:"-"` //do not unmarshal to json on save
+	SkipRules      *matching.SkipRules      `json:"-"` //do not unmarshal to json on save
+	Filter         *RepositoryFilter        `json:"-"` //do not unmarshal to json on save
+	Providers      map[string]*Provider     `json:"-"` //do not unmarshal to json on save
+	SSHAuth        transport.AuthMethod     `json:"-"` //do not unmarshal to json on save
+	IsLocalSession bool
+	Signatures     matching.Signatures `json:"-"` //do not unmarshal to json on save
+
+	lastCheckpoint    time.Time
+	findingGroupIndex map[string]*matching.FindingGroup
+	suppressedIds     map[string]bool
 }
 
 func (s *Session) Initialize() {
@@ -72,10 +155,18 @@ func (s *Session) Initialize() {
 	s.InitLogger()
 	s.InitThreads()
 	s.InitAccessToken()
+	s.InitBaseUrls()
+	s.InitSourceTypes()
 	s.InitSignatures()
+	s.InitSkipRules()
+	s.InitBaseline()
+	s.InitAllowlist()
 	s.ValidateTokenConfig()
 	s.InitAPIClient()
-	s.InitRouter()
+	s.InitSSHAuth()
+	if !*s.Options.Headless {
+		s.InitRouter()
+	}
 }
 
 func (s *Session) InitSignatures() {
@@ -86,55 +177,165 @@ func (s *Session) InitSignatures() {
 	}
 }
 
+func (s *Session) InitSkipRules() {
+	if *s.Options.SkipRules == "" {
+		s.SkipRules = matching.DefaultSkipRules()
+		return
+	}
+	skipRules, err := matching.LoadSkipRules(*s.Options.SkipRules)
+	if err != nil {
+		s.Out.Fatal("Error loading skip rules: %s\n", err)
+	}
+	s.SkipRules = skipRules
+}
+
 func (s *Session) Finish() {
 	s.Stats.FinishedAt = time.Now()
 	s.Stats.Status = StatusFinished
+	if s.Store != nil {
+		s.SaveCheckpoint(true)
+	}
+}
+
+// closes the session database, after which the session can no longer record or return findings
+func (s *Session) Close() {
+	if s.Store == nil {
+		return
+	}
+	s.Lock()
+	defer s.Unlock()
+	if err := s.Store.Close(); err != nil {
+		s.Out.Error("Error closing database %s: %s\n", *s.Options.Database, err)
+	}
 }
 
-func (s *Session) AddTarget(target *common.Owner) {
+// adds the target unless it was added before, reporting whether it was added
+func (s *Session) AddTarget(target *common.Owner) bool {
 	s.Lock()
 	defer s.Unlock()
 	for _, t := range s.Targets {
-		if *target.ID == *t.ID {
-			return
+		//GitLab numbers users and groups separately
+		if *target.ID == *t.ID && (target.Type == nil || t.Type == nil || *target.Type == *t.Type) && sameSourceType(target.SourceType, t.SourceType) {
+			return false
 		}
 	}
 	s.Targets = append(s.Targets, target)
+	s.appendToStore(store.BucketTargets, target)
+	return true
 }
 
 func (s *Session) AddRepository(repository *common.Repository) {
 	s.Lock()
 	defer s.Unlock()
 	for _, r := range s.Repositories {
-		if *repository.ID == *r.ID {
+		if *repository.ID == *r.ID && sameSourceType(repository.SourceType, r.SourceType) {
 			return
 		}
 	}
 	s.Repositories = append(s.Repositories, repository)
+	s.appendToStore(store.BucketRepositories, repository)
 }
 
 func (s *Session) AddFinding(finding *matching.Finding) {
+	const MaxStrLen = 100
+	group, isNew, err := s.addFindingToGroup(finding)
+	if err != nil {
+		s.Out.Error("Error recording finding: %s\n", err)
+		return
+	}
+	if group == nil {
+		//commits interrupted mid-analysis are analyzed again when a session is resumed
+		return
+	}
+	//keeps the lines of a finding together
 	s.Lock()
 	defer s.Unlock()
-	const MaxStrLen = 100
-	s.Findings = append(s.Findings, finding)
+	if !isNew {
+		s.Out.Info(" SEEN AGAIN: %s in %s (commit %s, %d occurrences)\n", finding.ContentSignatureDescription,
+			finding.FilePath, finding.CommitHash, group.Occurrences)
+		s.Stats.IncrementFindings()
+		return
+	}
 	s.Out.Warn(" %s: %s, %s\n", strings.ToUpper(finding.Action), "File Match: "+finding.FileSignatureDescription, "Content Match: "+finding.ContentSignatureDescription)
+	s.Out.Info("  Severity..................: %s\n", finding.Severity)
 	s.Out.Info("  Path......................: %s\n", finding.FilePath)
 	s.Out.Info("  Repo......................: %s\n", finding.CloneUrl)
 	s.Out.Info("  Message...................: %s\n", common.TruncateString(finding.CommitMessage, MaxStrLen))
 	s.Out.Info("  Author....................: %s\n", finding.CommitAuthor)
+	if len(finding.Refs) > 0 {
+		s.Out.Info("  Refs......................: %s\n", common.TruncateString(strings.Join(finding.Refs, ", "), MaxStrLen))
+	}
+	for _, pullRequest := range finding.PullRequests {
+		s.Out.Info("  Pull Request..............: #%d %s\n", pullRequest.Number, pullRequest.Url)
+	}
 	if finding.FileSignatureComment != "" {
 		s.Out.Info("  FileSignatureComment......: %s\n", common.TruncateString(finding.FileSignatureComment, MaxStrLen))
 	}
 	if finding.ContentSignatureComment != "" {
 		s.Out.Info("  ContentSignatureComment...:%s\n", common.TruncateString(finding.ContentSignatureComment, MaxStrLen))
 	}
+	if finding.Secret != "" {
+		s.Out.Info("  Line......................: %s\n", finding.DescribeLine())
+		s.Out.Info("  Secret....................: %s\n", common.TruncateString(finding.Secret, MaxStrLen))
+		if finding.Entropy > 0 {
+			s.Out.Info("  Entropy...................: %.2f\n", finding.Entropy)
+		}
+	}
 	s.Out.Info("  File URL...: %s\n", finding.FileUrl)
 	s.Out.Info("  Commit URL.: %s\n", finding.CommitUrl)
 	s.Out.Info(" ------------------------------------------------\n\n")
 	s.Stats.IncrementFindings()
 }
 
+// records the finding in the group of its fingerprint, returning the group and whether the group is new,
+// or a nil group when the finding was recorded before
+func (s *Session) addFindingToGroup(finding *matching.Finding) (*matching.FindingGroup, bool, error) {
+	if s.Store != nil {
+		return s.addFindingToStore(finding)
+	}
+	s.Lock()
+	defer s.Unlock()
+	group := s.findingGroup(finding.Fingerprint)
+	if group != nil && group.Contains(finding.Id) {
+		return nil, false, nil
+	}
+	s.Findings = append(s.Findings, finding)
+	if group != nil {
+		group.Add(finding)
+		return group, false, nil
+	}
+	group = matching.NewFindingGroup(finding)
+	s.FindingGroups = append(s.FindingGroups, group)
+	s.findingGroupIndex[group.Fingerprint] = group
+	return group, true, nil
+}
+
+// counts the findings at or above the given severity
+func (s *Session) CountFindingsAtOrAbove(severity string) (int, error) {
+	findings, err := s.GetFindings()
+	if err != nil {
+		return 0, err
+	}
+	count := 0
+	for _, finding := range findings {
+		if matching.SeverityRank(finding.Severity) >= matching.SeverityRank(severity) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (s *Session) findingGroup(fingerprint string) *matching.FindingGroup {
+	//sessions loaded from a file come with their groups but not the index of them
+	if s.findingGroupIndex == nil {
+		s.findingGroupIndex = make(map[string]*matching.FindingGroup)
+		for _, group := range s.FindingGroups {
+			s.findingGroupIndex[group.Fingerprint] = group
+		}
+	}
+	return s.findingGroupIndex[fingerprint]
+}
+
 func (s *Session) InitStats() {
 	if s.Stats != nil {
 		return
@@ -148,6 +349,7 @@ func (s *Session) InitStats() {
 		Commits:      0,
 		Files:        0,
 		Findings:     0,
+		Suppressed:   0,
 	}
 }
 
@@ -158,36 +360,208 @@ func (s *Session) InitLogger() {
 }
 
 func (s *Session) InitAccessToken() {
-	if *s.Options.GithubAccessToken == "" {
-		s.Github.AccessToken = os.Getenv(GitHubAccessTokenEnvVariable)
-	} else {
-		s.Github.AccessToken = *s.Options.GithubAccessToken
+	var err error
+	s.Github.AccessTokens, err = accessTokens(*s.Options.GithubAccessToken, *s.Options.GithubAccessTokenFile, GitHubAccessTokenEnvVariable)
+	if err != nil {
+		s.Out.Fatal("Error reading GitHub access tokens: %s\n", err)
 	}
-	if *s.Options.GitLabAccessToken == "" {
-		s.GitLab.AccessToken = os.Getenv(GitLabAccessTokenEnvVariable)
-	} else {
-		s.GitLab.AccessToken = *s.Options.GitLabAccessToken
+	s.GitLab.AccessTokens, err = accessTokens(*s.Options.GitLabAccessToken, *s.Options.GitLabAccessTokenFile, GitLabAccessTokenEnvVariable)
+	if err != nil {
+		s.Out.Fatal("Error reading GitLab access tokens: %s\n", err)
+	}
+	s.Bitbucket.AccessTokens, err = accessTokens(*s.Options.BitbucketAccessToken, *s.Options.BitbucketAccessTokenFile, BitbucketAccessTokenEnvVariable)
+	if err != nil {
+		s.Out.Fatal("Error reading Bitbucket access tokens: %s\n", err)
+	}
+	if len(s.Github.AccessTokens) > 0 {
+		s.Github.AccessToken = s.Github.AccessTokens[0]
+	}
+	if len(s.GitLab.AccessTokens) > 0 {
+		s.GitLab.AccessToken = s.GitLab.AccessTokens[0]
+	}
+	if len(s.Bitbucket.AccessTokens) > 0 {
+		s.Bitbucket.AccessToken = s.Bitbucket.AccessTokens[0]
+	}
+}
+
+// reads the access tokens given with an option, a file of one token per line, or else the environment variable,
+// where the option and environment variable take a comma separated list
+func accessTokens(option string, file string, envVariable string) ([]string, error) {
+	value := option
+	if file != "" {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		value += "\n" + string(data)
+	} else if value == "" {
+		value = os.Getenv(envVariable)
+	}
+	var tokens []string
+	for _, token := range strings.FieldsFunc(value, func(r rune) bool { return r == ',' || r == '\n' || r == '\r' }) {
+		token = strings.TrimSpace(token)
+		if token != "" && !strings.HasPrefix(token, "#") {
+			tokens = append(tokens, token)
+		}
+	}
+	return tokens, nil
+}
+
+func (s *Session) InitBaseUrls() {
+	s.Github.ApiUrl = *s.Options.GithubApiUrl
+	s.Github.WebUrl = strings.TrimSuffix(*s.Options.GithubWebUrl, "/")
+	s.Github.RawUrl = strings.TrimSuffix(*s.Options.GithubRawUrl, "/")
+	s.GitLab.ApiUrl = *s.Options.GitLabApiUrl
+	s.GitLab.WebUrl = strings.TrimSuffix(*s.Options.GitLabWebUrl, "/")
+	s.GitLab.RawUrl = strings.TrimSuffix(*s.Options.GitLabRawUrl, "/")
+	s.Bitbucket.ApiUrl = strings.TrimSuffix(*s.Options.BitbucketApiUrl, "/")
+	s.Bitbucket.WebUrl = strings.TrimSuffix(*s.Options.BitbucketWebUrl, "/")
+	if s.Bitbucket.WebUrl == "" {
+		s.Bitbucket.WebUrl = strings.TrimSuffix(s.Bitbucket.ApiUrl, BitbucketApiPath)
+	}
+}
+
+// records the code host of findings loaded from sessions saved before findings recorded it, which the web
+// interface names them by
+func (s *Session) InitSourceTypes() {
+	for _, findings := range [][]*matching.Finding{s.Findings, s.Suppressed} {
+		for _, finding := range findings {
+			finding.SourceType = finding.GetSourceType(s.GitLab.WebUrl)
+		}
 	}
 }
 
 func (s *Session) ValidateTokenConfig() {
 	if *s.Options.Load == "" {
-		if s.GitLab.AccessToken != "" && s.Github.AccessToken != "" {
-			s.Out.Fatal("Both a GitLab and Github token are present.  Only one may be set.\n")
+		githubApp := *s.Options.GithubAppId != 0
+		noTokens := s.GitLab.AccessToken == "" && s.Github.AccessToken == "" && s.Bitbucket.AccessToken == "" && !githubApp
+		if *s.Options.Local || (noTokens && s.targetsAreDirectories()) {
+			s.IsLocalSession = true
+			return
+		}
+		if githubApp && s.Github.AccessToken != "" {
+			s.Out.Fatal("Both a Github App and a Github token are present.  Only one may be set.\n")
+		}
+		if githubApp && *s.Options.GithubAppPrivateKey == "" {
+			s.Out.Fatal("A Github App requires its private key, given with -github-app-private-key.\n")
+		}
+		if s.Bitbucket.AccessToken != "" && s.Bitbucket.ApiUrl == "" {
+			s.Out.Fatal("Bitbucket Server has no public instance, give the URL of its API with -bitbucket-api-url.\n")
 		}
-		if s.GitLab.AccessToken == "" && s.Github.AccessToken == "" {
+		if noTokens {
 			s.Out.Fatal("No valid API token was found.\n")
 		}
 	}
-	s.IsGithubSession = s.Github.AccessToken != ""
 }
 
+func (s *Session) targetsAreDirectories() bool {
+	if len(s.Options.Logins) == 0 {
+		return false
+	}
+	for _, login := range s.Options.Logins {
+		if !common.DirectoryExists(login) {
+			return false
+		}
+	}
+	return true
+}
+
+// sets up a provider for each code host an access token was given for
 func (s *Session) InitAPIClient() {
-	if s.IsGithubSession {
-		s.Client = gh.Client.NewClient(gh.Client{}, s.Github.AccessToken)
-	} else {
-		s.Client = gl.Client.NewClient(gl.Client{}, s.GitLab.AccessToken, s.Out)
+	s.Providers = map[string]*Provider{}
+	if s.IsLocalSession {
+		s.Providers[common.SourceTypeLocal] = &Provider{Type: common.SourceTypeLocal, Client: local.Client.NewClient(local.Client{})}
+		return
+	}
+	if s.Github.AccessToken != "" || *s.Options.GithubAppId != 0 {
+		provider := &Provider{Type: common.SourceTypeGithub}
+		if *s.Options.GithubAppId != 0 {
+			provider.Tokens = s.githubAppTokenPool()
+		} else {
+			provider.Tokens = newTokenPool(s.Github.AccessTokens)
+		}
+		client, err := gh.Client.NewClient(gh.Client{}, s.Github.ApiUrl, s.apiHttpClient(provider, gh.SetToken))
+		if err != nil {
+			s.Out.Fatal("Error initializing Github client: %s\n", err)
+		}
+		client.IncludeForks = *s.Options.IncludeForks
+		client.Logger = s.Out
+		provider.Client = client
+		s.Providers[provider.Type] = provider
+	}
+	if s.GitLab.AccessToken != "" {
+		provider := &Provider{Type: common.SourceTypeGitLab, Tokens: newTokenPool(s.GitLab.AccessTokens)}
+		client, err := gl.Client.NewClient(gl.Client{}, s.GitLab.ApiUrl, s.apiHttpClient(provider, gl.SetToken))
+		if err != nil {
+			s.Out.Fatal("Error initializing GitLab client: %s\n", err)
+		}
+		client.IncludeForks = *s.Options.IncludeForks
+		provider.Client = client
+		s.Providers[provider.Type] = provider
+	}
+	if s.Bitbucket.AccessToken != "" {
+		provider := &Provider{Type: common.SourceTypeBitbucket, Tokens: newTokenPool(s.Bitbucket.AccessTokens)}
+		client, err := bb.Client.NewClient(bb.Client{}, s.Bitbucket.ApiUrl, s.apiHttpClient(provider, bb.SetToken))
+		if err != nil {
+			s.Out.Fatal("Error initializing Bitbucket client: %s\n", err)
+		}
+		client.IncludeForks = *s.Options.IncludeForks
+		client.Logger = s.Out
+		provider.Client = client
+		s.Providers[provider.Type] = provider
+	}
+}
+
+// sets up cloning over SSH, which local sessions have no use for
+func (s *Session) InitSSHAuth() {
+	if !*s.Options.SSH || s.IsLocalSession {
+		return
+	}
+	auth, err := common.NewSSHAuth(*s.Options.SSHKey, os.Getenv(SSHKeyPassphraseEnvVariable), *s.Options.SSHKnownHosts)
+	if err != nil {
+		s.Out.Fatal("Error setting up SSH clones: %s\n", err)
+	}
+	s.SSHAuth = auth
+}
+
+// an HTTP client for API requests authenticated with the provider's tokens, which reports the remaining quota
+// in the session stats
+func (s *Session) apiHttpClient(provider *Provider, setToken func(req *http.Request, token string)) *http.Client {
+	if s.Stats.RateLimits == nil {
+		s.Stats.RateLimits = map[string]*common.RateLimit{}
+	}
+	rateLimit := &common.RateLimit{}
+	s.Stats.RateLimits[provider.Type] = rateLimit
+	return &http.Client{Transport: common.NewRateLimitTransport(rateLimit, s.Out, provider.Tokens, setToken)}
+}
+
+func newTokenPool(tokens []string) *common.TokenPool {
+	var sources []common.TokenSource
+	for _, token := range tokens {
+		sources = append(sources, common.StaticToken(token))
+	}
+	return common.NewTokenPool(sources...)
+}
+
+// a pool of the installation token of a GitHub App, refreshed as it expires
+func (s *Session) githubAppTokenPool() *common.TokenPool {
+	source, err := gh.NewAppTokenSource(s.Github.ApiUrl, int64(*s.Options.GithubAppId), int64(*s.Options.GithubAppInstallationId), *s.Options.GithubAppPrivateKey)
+	if err != nil {
+		s.Out.Fatal("Error loading Github App private key: %s\n", err)
 	}
+	if source.InstallationID == 0 {
+		if len(s.Options.Logins) == 0 {
+			s.Out.Fatal("Please provide the Github App installation ID with -github-app-installation-id\n")
+		}
+		if err := source.FindInstallation(s.Options.Logins[0]); err != nil {
+			s.Out.Fatal("Error finding Github App installation: %s\n", err)
+		}
+	}
+	if _, err := source.Token(); err != nil {
+		s.Out.Fatal("Error authenticating as Github App: %s\n", err)
+	}
+	s.Out.Debug("Authenticated as installation %d of Github App %d.\n", source.InstallationID, source.AppID)
+	return common.NewTokenPool(source)
 }
 
 func (s *Session) InitThreads() {
@@ -209,17 +583,65 @@ func (s *Session) InitRouter() {
 }
 
 func (s *Session) SaveToFile(location string) error {
+	if s.Store != nil {
+		//export the findings kept in the database along with the rest of the session
+		findings, err := s.GetFindings()
+		if err != nil {
+			return err
+		}
+		groups, err := s.GetFindingGroups()
+		if err != nil {
+			return err
+		}
+		suppressed, err := s.GetSuppressedFindings()
+		if err != nil {
+			return err
+		}
+		s.Findings, s.FindingGroups, s.Suppressed = findings, groups, suppressed
+		defer func() {
+			s.Findings, s.FindingGroups, s.Suppressed = nil, nil, nil
+		}()
+	}
 	sessionJson, err := json.Marshal(s)
 	if err != nil {
 		return err
 	}
-	err = ioutil.WriteFile(location, sessionJson, 0644)
+	err = writeFileAtomically(location, sessionJson)
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
+func (s *Session) SaveReportToFile(location string, format string) error {
+	switch format {
+	case ReportFormatSarif:
+		return s.SaveSarifToFile(location)
+	case ReportFormatJson:
+		findings, err := s.GetVisibleFindings()
+		if err != nil {
+			return err
+		}
+		findingsJson, err := json.MarshalIndent(findings, "", "  ")
+		if err != nil {
+			return err
+		}
+		return ioutil.WriteFile(location, findingsJson, 0644)
+	case ReportFormatDiff:
+		diff, err := s.Diff()
+		if err != nil {
+			return err
+		}
+		diffJson, err := json.MarshalIndent(diff, "", "  ")
+		if err != nil {
+			return err
+		}
+		return ioutil.WriteFile(location, diffJson, 0644)
+	default:
+		return errors.New(fmt.Sprintf("Unsupported report format: %s", format))
+	}
+}
+
 func (s *Stats) IncrementTargets() {
 	s.Lock()
 	defer s.Unlock()
@@ -250,6 +672,29 @@ func (s *Stats) IncrementFindings() {
 	s.Findings++
 }
 
+func (s *Stats) IncrementSuppressed() {
+	s.Lock()
+	defer s.Unlock()
+	s.Suppressed++
+}
+
+func (s *Stats) IncrementSkipped(reason string) {
+	s.Lock()
+	defer s.Unlock()
+	switch reason {
+	case matching.SkipReasonExtension:
+		s.Skipped.Extension++
+	case matching.SkipReasonPath:
+		s.Skipped.Path++
+	case matching.SkipReasonPattern:
+		s.Skipped.Pattern++
+	case matching.SkipReasonSize:
+		s.Skipped.Size++
+	case matching.SkipReasonBinary:
+		s.Skipped.Binary++
+	}
+}
+
 func (s *Stats) UpdateProgress(current int, total int) {
 	s.Lock()
 	defer s.Unlock()
@@ -268,10 +713,49 @@ func NewSession() (*Session, error) {
 		return nil, err
 	}
 
-	if *session.Options.Save != "" && common.FileExists(*session.Options.Save) {
+	if *session.Options.Resume != "" {
+		if *session.Options.Load != "" {
+			return nil, errors.New("Options -load and -resume can not be combined.")
+		}
+		//checkpoints keep being written to the session file being resumed unless saving elsewhere
+		session.Options.Load = session.Options.Resume
+		if *session.Options.Save == "" {
+			session.Options.Save = session.Options.Resume
+		}
+	} else if *session.Options.Save != "" && common.FileExists(*session.Options.Save) {
 		return nil, errors.New(fmt.Sprintf("File: %s already exists.", *session.Options.Save))
 	}
 
+	if *session.Options.Baseline != "" && *session.Options.Baseline == *session.Options.Database {
+		return nil, errors.New("Options -baseline and -db can not name the same database.")
+	}
+
+	if *session.Options.Headless && *session.Options.Report == "" {
+		return nil, errors.New("Option -headless requires a -report to write findings to.")
+	}
+
+	if *session.Options.Report != "" && common.FileExists(*session.Options.Report) {
+		return nil, errors.New(fmt.Sprintf("File: %s already exists.", *session.Options.Report))
+	}
+
+	switch *session.Options.ReportFormat {
+	case ReportFormatSarif, ReportFormatJson:
+	case ReportFormatDiff:
+		if *session.Options.Baseline == "" {
+			return nil, errors.New("The diff report format requires a -baseline session.")
+		}
+	default:
+		return nil, errors.New(fmt.Sprintf("Unsupported report format: %s", *session.Options.ReportFormat))
+	}
+
+	if err := matching.ValidateSeverity(*session.Options.FailSeverity); err != nil {
+		return nil, err
+	}
+
+	if session.Filter, err = NewRepositoryFilter(session.Options); err != nil {
+		return nil, err
+	}
+
 	if *session.Options.Load != "" {
 		if !common.FileExists(*session.Options.Load) {
 			return nil, errors.New(fmt.Sprintf("Session file %s does not exist or is not readable.", *session.Options.Load))
@@ -285,6 +769,17 @@ func NewSession() (*Session, error) {
 		}
 	}
 
+	if *session.Options.Database != "" {
+		if err := session.openStore(*session.Options.Database); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(session.Options.Logins) == 0 {
+		session.Options.Logins = session.Logins
+	}
+	session.Logins = session.Options.Logins
+
 	session.Version = common.Version
 	session.Initialize()
 
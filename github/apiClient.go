@@ -2,25 +2,41 @@ package github
 
 import (
 	"context"
+	"net/http"
+	"net/url"
+	"strings"
 
 	"github.com/codeEmitter/gitrob/common"
 	"github.com/google/go-github/github"
-	"golang.org/x/oauth2"
 )
 
 type Client struct {
-	apiClient *github.Client
+	apiClient    *github.Client
+	IncludeForks bool
+	Logger       *common.Logger
 }
 
-func (c Client) NewClient(token string) (apiClient Client) {
-	ctx := context.Background()
-	ts := oauth2.StaticTokenSource(
-		&oauth2.Token{AccessToken: token},
-	)
-	tc := oauth2.NewClient(ctx, ts)
-	c.apiClient = github.NewClient(tc)
+// the HTTP client authenticates requests and carries the rate limit handling shared with the GitLab client
+func (c Client) NewClient(apiUrl string, httpClient *http.Client) (apiClient Client, err error) {
+	c.apiClient = github.NewClient(httpClient)
 	c.apiClient.UserAgent = common.UserAgent
-	return c
+	if apiUrl != "" {
+		//the API client requires a trailing slash to resolve relative endpoints
+		if !strings.HasSuffix(apiUrl, "/") {
+			apiUrl += "/"
+		}
+		baseUrl, err := url.Parse(apiUrl)
+		if err != nil {
+			return c, err
+		}
+		c.apiClient.BaseURL = baseUrl
+	}
+	return c, nil
+}
+
+// authenticates an API request with an access token
+func SetToken(req *http.Request, token string) {
+	req.Header.Set("Authorization", "token "+token)
 }
 
 func (c Client) GetUserOrOrganization(login string) (*common.Owner, error) {
@@ -47,9 +63,13 @@ func (c Client) GetUserOrOrganization(login string) (*common.Owner, error) {
 func (c Client) GetRepositoriesFromOwner(target common.Owner) ([]*common.Repository, error) {
 	var allRepos []*common.Repository
 	ctx := context.Background()
+	//sources leaves forks out, owner keeps them for the check below to include
 	opt := &github.RepositoryListOptions{
 		Type: "sources",
 	}
+	if c.IncludeForks {
+		opt.Type = "owner"
+	}
 
 	for {
 		repos, resp, err := c.apiClient.Repositories.List(ctx, *target.Login, opt)
@@ -57,17 +77,37 @@ func (c Client) GetRepositoriesFromOwner(target common.Owner) ([]*common.Reposit
 			return allRepos, err
 		}
 		for _, repo := range repos {
-			if !*repo.Fork {
+			if !*repo.Fork || c.IncludeForks {
 				r := common.Repository{
 					Owner:         repo.Owner.Login,
 					ID:            repo.ID,
 					Name:          repo.Name,
 					FullName:      repo.FullName,
 					CloneURL:      repo.CloneURL,
+					SSHCloneURL:   repo.SSHURL,
 					URL:           repo.HTMLURL,
 					DefaultBranch: repo.DefaultBranch,
 					Description:   repo.Description,
 					Homepage:      repo.Homepage,
+					Fork:          repo.Fork,
+					Visibility:    github.String(common.VisibilityPublic),
+					Archived:      repo.Archived,
+				}
+				if repo.GetPrivate() {
+					r.Visibility = github.String(common.VisibilityPrivate)
+				}
+				if repo.PushedAt != nil {
+					r.PushedAt = &repo.PushedAt.Time
+				}
+				if repo.Size != nil {
+					size := int64(*repo.Size)
+					r.Size = &size
+				}
+				if *repo.Fork {
+					//without its upstream the whole history of a fork is analyzed
+					if r.ParentCloneURL, err = c.getParent(repo); err != nil {
+						c.Logger.Error(" Failed to retrieve the repository %s was forked from, analyzing its whole history: %s\n", *repo.FullName, err)
+					}
 				}
 				allRepos = append(allRepos, &r)
 			}
@@ -81,6 +121,24 @@ func (c Client) GetRepositoriesFromOwner(target common.Owner) ([]*common.Reposit
 	return allRepos, nil
 }
 
+// the repository a fork was created from is only included when getting the fork itself
+func (c Client) getParent(repo *github.Repository) (*string, error) {
+	ctx := context.Background()
+	fork, _, err := c.apiClient.Repositories.Get(ctx, *repo.Owner.Login, *repo.Name)
+	if err != nil {
+		return nil, err
+	}
+	if fork.Parent == nil {
+		return nil, nil
+	}
+	return fork.Parent.CloneURL, nil
+}
+
+// GitHub organizations have no subgroups
+func (c Client) GetSubgroups(target common.Owner) ([]*common.Owner, error) {
+	return nil, nil
+}
+
 func (c Client) GetOrganizationMembers(target common.Owner) ([]*common.Owner, error) {
 	var allMembers []*common.Owner
 	ctx := context.Background()
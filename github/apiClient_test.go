@@ -0,0 +1,61 @@
+package github
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/codeEmitter/gitrob/common"
+)
+
+// a GitHub API stand-in for the organization acme, owning the repository alpha and a fork of upstream/beta
+func newTestRepositoryApi() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/users/acme/repos":
+			//like GitHub, sources leaves forks out
+			repos := []string{`{"id": 1, "name": "alpha", "full_name": "acme/alpha", "fork": false, "owner": {"login": "acme"}}`}
+			if r.URL.Query().Get("type") != "sources" {
+				repos = append(repos, `{"id": 2, "name": "beta", "full_name": "acme/beta", "fork": true, "owner": {"login": "acme"}}`)
+			}
+			fmt.Fprintf(w, "[%s]", strings.Join(repos, ", "))
+		case "/repos/acme/beta":
+			fmt.Fprint(w, `{"id": 2, "name": "beta", "fork": true, "parent": {"clone_url": "https://github.com/upstream/beta.git"}}`)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+}
+
+func TestGetRepositoriesFromOwnerIncludesForksWhenAsked(t *testing.T) {
+	server := newTestRepositoryApi()
+	defer server.Close()
+	owner := common.Owner{Login: common.String("acme"), Type: common.String(common.TargetTypeOrganization)}
+	for _, includeForks := range []bool{false, true} {
+		client, err := Client{IncludeForks: includeForks, Logger: &common.Logger{}}.NewClient(server.URL, server.Client())
+		if err != nil {
+			t.Fatal(err)
+		}
+		repos, err := client.GetRepositoriesFromOwner(owner)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var names []string
+		for _, repo := range repos {
+			names = append(names, *repo.FullName)
+		}
+		want := "acme/alpha"
+		if includeForks {
+			want = "acme/alpha, acme/beta"
+		}
+		if strings.Join(names, ", ") != want {
+			t.Errorf("GetRepositoriesFromOwner(include forks %t) = %s, want %s", includeForks, strings.Join(names, ", "), want)
+			continue
+		}
+		if includeForks && (repos[1].ParentCloneURL == nil || *repos[1].ParentCloneURL != "https://github.com/upstream/beta.git") {
+			t.Errorf("GetRepositoriesFromOwner() did not link fork acme/beta to the repository it was forked from")
+		}
+	}
+}
@@ -0,0 +1,171 @@
+package github
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/codeEmitter/gitrob/common"
+)
+
+const (
+	//installation tokens expire after an hour and are replaced this long before they do
+	AppTokenRefreshMargin = 5 * time.Minute
+	//GitHub refuses app JSON web tokens that expire more than ten minutes after they were issued
+	appJwtLifetime  = 9 * time.Minute
+	appJwtClockSkew = 60 * time.Second
+	appAcceptHeader = "application/vnd.github.machine-man-preview+json"
+)
+
+// authenticates as an installation of a GitHub App, exchanging a JSON web token signed with the app's private
+// key for installation tokens
+type AppTokenSource struct {
+	sync.Mutex
+
+	AppID          int64
+	InstallationID int64
+	apiUrl         string
+	key            *rsa.PrivateKey
+	httpClient     *http.Client
+	token          string
+	expiresAt      time.Time
+}
+
+type appInstallation struct {
+	ID int64 `json:"id"`
+}
+
+type appInstallationToken struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+func NewAppTokenSource(apiUrl string, appID int64, installationID int64, privateKeyFile string) (*AppTokenSource, error) {
+	data, err := ioutil.ReadFile(privateKeyFile)
+	if err != nil {
+		return nil, err
+	}
+	key, err := parsePrivateKey(data)
+	if err != nil {
+		return nil, err
+	}
+	return &AppTokenSource{
+		AppID:          appID,
+		InstallationID: installationID,
+		apiUrl:         strings.TrimSuffix(apiUrl, "/"),
+		key:            key,
+		httpClient:     &http.Client{Timeout: 30 * time.Second},
+	}, nil
+}
+
+// GitHub issues PKCS#1 keys, though PKCS#8 keys converted from them are accepted too
+func parsePrivateKey(data []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("GitHub App private key is not PEM encoded.")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("Unable to parse GitHub App private key: %s", err))
+	}
+	key, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, errors.New("GitHub App private key is not an RSA key.")
+	}
+	return key, nil
+}
+
+// finds the installation of the app on an organization or user account, for when no installation ID is given
+func (s *AppTokenSource) FindInstallation(login string) error {
+	var installation appInstallation
+	err := s.appRequest("GET", fmt.Sprintf("/orgs/%s/installation", login), &installation)
+	if err != nil {
+		if userErr := s.appRequest("GET", fmt.Sprintf("/users/%s/installation", login), &installation); userErr != nil {
+			return errors.New(fmt.Sprintf("GitHub App %d is not installed on %s: %s", s.AppID, login, err))
+		}
+	}
+	s.Lock()
+	s.InstallationID = installation.ID
+	s.Unlock()
+	return nil
+}
+
+// returns the current installation token, requesting a new one when it is about to expire
+func (s *AppTokenSource) Token() (string, error) {
+	s.Lock()
+	defer s.Unlock()
+	if s.token != "" && time.Now().Add(AppTokenRefreshMargin).Before(s.expiresAt) {
+		return s.token, nil
+	}
+	var token appInstallationToken
+	path := fmt.Sprintf("/app/installations/%d/access_tokens", s.InstallationID)
+	if err := s.appRequest("POST", path, &token); err != nil {
+		return "", errors.New(fmt.Sprintf("Unable to create GitHub App installation token: %s", err))
+	}
+	s.token, s.expiresAt = token.Token, token.ExpiresAt
+	return s.token, nil
+}
+
+// makes an API request authenticated as the app itself
+func (s *AppTokenSource) appRequest(method string, path string, result interface{}) error {
+	jwt, err := s.jwt(time.Now())
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(method, s.apiUrl+path, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Authorization", "Bearer "+jwt)
+	req.Header.Set("Accept", appAcceptHeader)
+	req.Header.Set("User-Agent", common.UserAgent)
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		var message struct {
+			Message string `json:"message"`
+		}
+		json.Unmarshal(body, &message)
+		return errors.New(fmt.Sprintf("%s %s: %d %s", method, s.apiUrl+path, resp.StatusCode, message.Message))
+	}
+	return json.Unmarshal(body, result)
+}
+
+// a JSON web token identifying the app, signed with its private key
+func (s *AppTokenSource) jwt(now time.Time) (string, error) {
+	header, _ := json.Marshal(map[string]string{"alg": "RS256", "typ": "JWT"})
+	claims, _ := json.Marshal(map[string]int64{
+		"iat": now.Add(-appJwtClockSkew).Unix(),
+		"exp": now.Add(appJwtLifetime).Unix(),
+		"iss": s.AppID,
+	})
+	unsigned := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(claims)
+	hash := sha256.Sum256([]byte(unsigned))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, s.key, crypto.SHA256, hash[:])
+	if err != nil {
+		return "", err
+	}
+	return unsigned + "." + base64.RawURLEncoding.EncodeToString(signature), nil
+}
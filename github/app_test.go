@@ -0,0 +1,196 @@
+package github
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+const (
+	testAppID          = 42
+	testInstallationID = 7
+)
+
+// a GitHub API stand-in for app authentication, where the app is installed on the user acme
+type fakeAppApi struct {
+	sync.Mutex
+
+	key        *rsa.PublicKey
+	expiresIn  []time.Duration
+	tokens     int
+	requested  []string
+	jwtInvalid []string
+}
+
+func (f *fakeAppApi) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	f.Lock()
+	defer f.Unlock()
+	f.requested = append(f.requested, r.Method+" "+r.URL.Path)
+	if err := f.verifyJwt(r.Header.Get("Authorization")); err != nil {
+		f.jwtInvalid = append(f.jwtInvalid, fmt.Sprintf("%s %s: %s", r.Method, r.URL.Path, err))
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+	switch r.Method + " " + r.URL.Path {
+	case "GET /orgs/acme/installation":
+		w.WriteHeader(http.StatusNotFound)
+		fmt.Fprint(w, `{"message": "Not Found"}`)
+	case "GET /users/acme/installation":
+		fmt.Fprintf(w, `{"id": %d}`, testInstallationID)
+	case fmt.Sprintf("POST /app/installations/%d/access_tokens", testInstallationID):
+		expiresIn := time.Hour
+		if f.tokens < len(f.expiresIn) {
+			expiresIn = f.expiresIn[f.tokens]
+		}
+		f.tokens++
+		json.NewEncoder(w).Encode(appInstallationToken{
+			Token:     fmt.Sprintf("installation token %d", f.tokens),
+			ExpiresAt: time.Now().Add(expiresIn),
+		})
+	default:
+		w.WriteHeader(http.StatusNotFound)
+	}
+}
+
+func (f *fakeAppApi) verifyJwt(authorization string) error {
+	if !strings.HasPrefix(authorization, "Bearer ") {
+		return fmt.Errorf("not a bearer token: %q", authorization)
+	}
+	parts := strings.Split(strings.TrimPrefix(authorization, "Bearer "), ".")
+	if len(parts) != 3 {
+		return fmt.Errorf("%d parts", len(parts))
+	}
+	var header map[string]string
+	if err := decodeJwtPart(parts[0], &header); err != nil {
+		return err
+	}
+	if header["alg"] != "RS256" || header["typ"] != "JWT" {
+		return fmt.Errorf("header %v", header)
+	}
+	var claims map[string]int64
+	if err := decodeJwtPart(parts[1], &claims); err != nil {
+		return err
+	}
+	now := time.Now().Unix()
+	if claims["iss"] != testAppID {
+		return fmt.Errorf("issuer %d", claims["iss"])
+	}
+	if claims["iat"] > now || claims["exp"] <= now {
+		return fmt.Errorf("not valid now: issued at %d, expires at %d", claims["iat"], claims["exp"])
+	}
+	if claims["exp"]-claims["iat"] > int64((10 * time.Minute).Seconds()) {
+		return fmt.Errorf("expires more than ten minutes after it was issued")
+	}
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return err
+	}
+	hash := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	return rsa.VerifyPKCS1v15(f.key, crypto.SHA256, hash[:], signature)
+}
+
+func decodeJwtPart(part string, value interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(part)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, value)
+}
+
+func newTestAppTokenSource(t *testing.T, installationID int64, expiresIn ...time.Duration) (*AppTokenSource, *fakeAppApi, func()) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir, err := ioutil.TempDir("", "gitrob")
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyFile := filepath.Join(dir, "app.pem")
+	data := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	if err := ioutil.WriteFile(keyFile, data, 0600); err != nil {
+		t.Fatal(err)
+	}
+	api := &fakeAppApi{key: &key.PublicKey, expiresIn: expiresIn}
+	server := httptest.NewServer(api)
+	source, err := NewAppTokenSource(server.URL+"/", testAppID, installationID, keyFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return source, api, func() {
+		server.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+func TestAppTokenSourceSignsItsJwtWithTheAppKey(t *testing.T) {
+	source, api, done := newTestAppTokenSource(t, testInstallationID)
+	defer done()
+	token, err := source.Token()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != "installation token 1" {
+		t.Errorf("Token() = %q, want %q", token, "installation token 1")
+	}
+	if len(api.jwtInvalid) > 0 {
+		t.Errorf("invalid JSON web tokens: %v", api.jwtInvalid)
+	}
+}
+
+func TestAppTokenSourceRefreshesTokensAboutToExpire(t *testing.T) {
+	//the first token expires within the refresh margin, the second one doesn't
+	source, api, done := newTestAppTokenSource(t, testInstallationID, AppTokenRefreshMargin-time.Minute, time.Hour)
+	defer done()
+	var tokens []string
+	for i := 0; i < 3; i++ {
+		token, err := source.Token()
+		if err != nil {
+			t.Fatal(err)
+		}
+		tokens = append(tokens, token)
+	}
+	want := []string{"installation token 1", "installation token 2", "installation token 2"}
+	if strings.Join(tokens, ", ") != strings.Join(want, ", ") {
+		t.Errorf("Token() = %q, want %q", tokens, want)
+	}
+	if api.tokens != 2 {
+		t.Errorf("%d installation tokens were created, want 2", api.tokens)
+	}
+}
+
+func TestAppTokenSourceFindsInstallationsOnUsers(t *testing.T) {
+	source, api, done := newTestAppTokenSource(t, 0)
+	defer done()
+	if err := source.FindInstallation("acme"); err != nil {
+		t.Fatal(err)
+	}
+	if source.InstallationID != testInstallationID {
+		t.Errorf("InstallationID = %d, want %d", source.InstallationID, testInstallationID)
+	}
+	want := []string{"GET /orgs/acme/installation", "GET /users/acme/installation"}
+	if strings.Join(api.requested, ", ") != strings.Join(want, ", ") {
+		t.Errorf("requested %q, want %q", api.requested, want)
+	}
+	if _, err := source.Token(); err != nil {
+		t.Error(err)
+	}
+	if err := source.FindInstallation("nobody"); err == nil {
+		t.Errorf("FindInstallation(%q) found an installation", "nobody")
+	}
+}
@@ -2,24 +2,34 @@ package gitlab
 
 import (
 	"fmt"
+	"net/http"
 	"strconv"
 	"strings"
-	"time"
 
 	"github.com/codeEmitter/gitrob/common"
 	"github.com/xanzy/go-gitlab"
 )
 
 type Client struct {
-	apiClient *gitlab.Client
-	logger *common.Logger
+	apiClient    *gitlab.Client
+	IncludeForks bool
 }
 
-func (c Client) NewClient(token string, logger *common.Logger) (apiClient Client) {
-	c.apiClient = gitlab.NewClient(nil, token)
+// the HTTP client authenticates requests and carries the rate limit handling shared with the GitHub client
+func (c Client) NewClient(apiUrl string, httpClient *http.Client) (apiClient Client, err error) {
+	c.apiClient = gitlab.NewClient(httpClient, "")
 	c.apiClient.UserAgent = common.UserAgent
-	c.logger = logger
-	return c
+	if apiUrl != "" {
+		if err := c.apiClient.SetBaseURL(apiUrl); err != nil {
+			return c, err
+		}
+	}
+	return c, nil
+}
+
+// authenticates an API request with an access token
+func SetToken(req *http.Request, token string) {
+	req.Header.Set("PRIVATE-TOKEN", token)
 }
 
 func (c Client) GetUserOrOrganization(login string) (*common.Owner, error) {
@@ -45,21 +55,48 @@ func (c Client) GetUserOrOrganization(login string) (*common.Owner, error) {
 			Bio:       gitlab.String(user.Bio),
 		}, nil
 	} else {
-		id := int64(org.ID)
-		return &common.Owner{
-			Login:     gitlab.String(org.Name),
-			ID:        &id,
-			Type:      gitlab.String(common.TargetTypeOrganization),
-			Name:      gitlab.String(org.Name),
-			AvatarURL: gitlab.String(org.AvatarURL),
-			URL:       gitlab.String(org.WebURL),
-			Company:   gitlab.String(org.FullName),
-			Blog:      emptyString,
-			Location:  emptyString,
-			Email:     emptyString,
-			Bio:       gitlab.String(org.Description),
-		}, nil
+		return newGroupOwner(org), nil
+	}
+}
+
+// groups are identified by their full path, such as group/subgroup, which shows where they are in the hierarchy
+func newGroupOwner(group *gitlab.Group) *common.Owner {
+	emptyString := gitlab.String("")
+	id := int64(group.ID)
+	return &common.Owner{
+		Login:     gitlab.String(group.FullPath),
+		ID:        &id,
+		Type:      gitlab.String(common.TargetTypeOrganization),
+		Name:      gitlab.String(group.Name),
+		AvatarURL: gitlab.String(group.AvatarURL),
+		URL:       gitlab.String(group.WebURL),
+		Company:   gitlab.String(group.FullName),
+		Blog:      emptyString,
+		Location:  emptyString,
+		Email:     emptyString,
+		Bio:       gitlab.String(group.Description),
+	}
+}
+
+// returns the direct subgroups of a group, each with the full path of its parent
+func (c Client) GetSubgroups(target common.Owner) ([]*common.Owner, error) {
+	var allSubgroups []*common.Owner
+	opt := &gitlab.ListSubgroupsOptions{}
+	id := strconv.FormatInt(*target.ID, 10)
+	for {
+		groups, response, err := c.apiClient.Groups.ListSubgroups(id, opt)
+		if err != nil {
+			return nil, err
+		}
+		for _, group := range groups {
+			allSubgroups = append(allSubgroups, newGroupOwner(group))
+		}
+		if response.NextPage == 0 {
+			break
+		}
+		opt.Page = response.NextPage
 	}
+	return allSubgroups, nil
 }
 
 func (c Client) GetOrganizationMembers(target common.Owner) ([]*common.Owner, error) {
@@ -110,65 +147,14 @@ func (c Client) GetRepositoriesFromOwner(target common.Owner) ([]*common.Reposit
 	return allProjects, nil
 }
 
-func (c Client) logRateLimitInfo(remaining int, wait time.Duration, isResetTime bool) {
-	resetTimeMsg := ""
-	if isResetTime {
-		resetTimeMsg = "(rate limit reset time)"
-	}
-	c.logger.Debug(" Remaining requests before GitLab rate limit: %d.  Waiting %f seconds. %s\n", remaining, wait.Seconds(), resetTimeMsg)
-}
-
-func (c Client) getRateLimitResetDuration(rateLimitReset string) time.Duration {
-	resetTime, _ := time.Parse(time.RFC1123, rateLimitReset)
-	return time.Until(resetTime)
-}
-
-func (c Client) handleRateLimit(response *gitlab.Response) {
-
-	remaining, _ := strconv.Atoi(response.Header.Get("RateLimit-Remaining"))
-
-	switch {
-	case remaining >= 0 && remaining <= 25:
-		reset := c.getRateLimitResetDuration(response.Header.Get("RateLimit-ResetTime"))
-		c.logRateLimitInfo(remaining, reset, true)
-		time.Sleep(reset)
-	case remaining >= 26 && remaining <= 100:
-		wait, _ := time.ParseDuration("5000ms")
-		c.logRateLimitInfo(remaining, wait, false)
-		time.Sleep(wait)
-	case remaining >= 101 && remaining <= 200:
-		wait, _ := time.ParseDuration("3000ms")
-		c.logRateLimitInfo(remaining, wait, false)
-		time.Sleep(wait)
-	case remaining >= 201 && remaining <= 250:
-		wait, _ := time.ParseDuration("2000ms")
-		c.logRateLimitInfo(remaining, wait, false)
-		time.Sleep(wait)
-	case remaining >= 251 && remaining <= 350:
-		wait, _ := time.ParseDuration("1000ms")
-		c.logRateLimitInfo(remaining, wait, false)
-		time.Sleep(wait)
-	case remaining >= 351 && remaining <= 400:
-		wait, _ := time.ParseDuration("500ms")
-		c.logRateLimitInfo(remaining, wait, false)
-		time.Sleep(wait)
-	case remaining >= 401 && remaining <= 450:
-		wait, _ := time.ParseDuration("250ms")
-		c.logRateLimitInfo(remaining, wait, false)
-		time.Sleep(wait)
-	}
-
-}
-
 func (c Client) getUser(login string) (*gitlab.User, error) {
-	users, response, err := c.apiClient.Users.ListUsers(&gitlab.ListUsersOptions{Username: gitlab.String(login)})
-	c.handleRateLimit(response)
+	users, _, err := c.apiClient.Users.ListUsers(&gitlab.ListUsersOptions{Username: gitlab.String(login)})
 	if err != nil {
 		return nil, err
 	}
 	if len(users) == 0 {
-		return nil, fmt.Errorf("No GitLab %s or %s %s was found.  If you are targeting a GitLab group, be sure to" +
-			" use an ID in place of a name.",
+		return nil, fmt.Errorf("No GitLab %s or %s %s was found.  If you are targeting a GitLab group, be sure to"+
+			" use its ID or full path, such as group/subgroup, in place of its name.",
 			strings.ToLower(common.TargetTypeUser),
 			strings.ToLower(common.TargetTypeOrganization),
 			login)
@@ -177,12 +163,12 @@ func (c Client) getUser(login string) (*gitlab.User, error) {
 }
 
 func (c Client) getOrganization(login string) (*gitlab.Group, error) {
-	id, err := strconv.Atoi(login)
-	if err != nil {
-		return nil, err
+	//groups are looked up by ID or by full path, such as group/subgroup
+	var gid interface{} = login
+	if id, err := strconv.Atoi(login); err == nil {
+		gid = id
 	}
-	org, response, err := c.apiClient.Groups.GetGroup(id)
-	c.handleRateLimit(response)
+	org, _, err := c.apiClient.Groups.GetGroup(gid)
 	if err != nil {
 		return nil, err
 	}
@@ -191,16 +177,15 @@ func (c Client) getOrganization(login string) (*gitlab.Group, error) {
 
 func (c Client) getUserProjects(id int) ([]*common.Repository, error) {
 	var allUserProjects []*common.Repository
-	listUserProjectsOps := &gitlab.ListProjectsOptions{}
+	listUserProjectsOps := &gitlab.ListProjectsOptions{Statistics: gitlab.Bool(true)}
 	for {
 		projects, response, err := c.apiClient.Projects.ListUserProjects(id, listUserProjectsOps)
-		c.handleRateLimit(response)
 		if err != nil {
 			return nil, err
 		}
 		for _, project := range projects {
-			//don't capture forks
-			if project.ForkedFromProject == nil {
+			//don't capture forks unless asked to
+			if project.ForkedFromProject == nil || c.IncludeForks {
 				id := int64(project.ID)
 				p := common.Repository{
 					Owner:         gitlab.String(project.Owner.Username),
@@ -208,11 +193,13 @@ func (c Client) getUserProjects(id int) ([]*common.Repository, error) {
 					Name:          gitlab.String(project.Name),
 					FullName:      gitlab.String(project.NameWithNamespace),
 					CloneURL:      gitlab.String(project.HTTPURLToRepo),
+					SSHCloneURL:   gitlab.String(project.SSHURLToRepo),
 					URL:           gitlab.String(project.WebURL),
 					DefaultBranch: gitlab.String(project.DefaultBranch),
 					Description:   gitlab.String(project.Description),
 					Homepage:      gitlab.String(project.WebURL),
 				}
+				setProjectAttributes(&p, project)
 				allUserProjects = append(allUserProjects, &p)
 			}
 		}
@@ -224,19 +211,34 @@ func (c Client) getUserProjects(id int) ([]*common.Repository, error) {
 	return allUserProjects, nil
 }
 
+// sets the attributes repositories are filtered by, where statistics are only returned to project members,
+// and the upstream of forks
+func setProjectAttributes(repository *common.Repository, project *gitlab.Project) {
+	repository.Visibility = gitlab.String(string(project.Visibility))
+	repository.Archived = gitlab.Bool(project.Archived)
+	repository.PushedAt = project.LastActivityAt
+	repository.Fork = gitlab.Bool(project.ForkedFromProject != nil)
+	if project.ForkedFromProject != nil {
+		repository.ParentCloneURL = gitlab.String(project.ForkedFromProject.HTTPURLToRepo)
+	}
+	if project.Statistics != nil {
+		size := project.Statistics.RepositorySize / 1024
+		repository.Size = &size
+	}
+}
+
 func (c Client) getGroupProjects(target common.Owner) ([]*common.Repository, error) {
 	var allGroupProjects []*common.Repository
-	listGroupProjectsOps := &gitlab.ListGroupProjectsOptions{}
+	listGroupProjectsOps := &gitlab.ListGroupProjectsOptions{Statistics: gitlab.Bool(true)}
 	id := strconv.FormatInt(*target.ID, 10)
 	for {
 		projects, response, err := c.apiClient.Groups.ListGroupProjects(id, listGroupProjectsOps)
-		c.handleRateLimit(response)
 		if err != nil {
 			return nil, err
 		}
 		for _, project := range projects {
-			//don't capture forks
-			if project.ForkedFromProject == nil {
+			//don't capture forks unless asked to
+			if project.ForkedFromProject == nil || c.IncludeForks {
 				id := int64(project.ID)
 				p := common.Repository{
 					Owner:         gitlab.String(project.Namespace.FullPath),
@@ -244,11 +246,13 @@ func (c Client) getGroupProjects(target common.Owner) ([]*common.Repository, err
 					Name:          gitlab.String(project.Name),
 					FullName:      gitlab.String(project.NameWithNamespace),
 					CloneURL:      gitlab.String(project.HTTPURLToRepo),
+					SSHCloneURL:   gitlab.String(project.SSHURLToRepo),
 					URL:           gitlab.String(project.WebURL),
 					DefaultBranch: gitlab.String(project.DefaultBranch),
 					Description:   gitlab.String(project.Description),
 					Homepage:      gitlab.String(project.WebURL),
 				}
+				setProjectAttributes(&p, project)
 				allGroupProjects = append(allGroupProjects, &p)
 			}
 		}
@@ -1,44 +1,3 @@
 package gitlab
 
-import (
-	"fmt"
-	"github.com/codeEmitter/gitrob/common"
-	"gopkg.in/src-d/go-git.v4"
-	"gopkg.in/src-d/go-git.v4/plumbing"
-	"gopkg.in/src-d/go-git.v4/plumbing/transport/http"
-	"gopkg.in/src-d/go-git.v4/storage/memory"
-	"io/ioutil"
-)
-
-func CloneRepository(cloneConfig *common.CloneConfiguration) (*git.Repository, string, error) {
-
-	cloneOptions := &git.CloneOptions{
-		URL:           *cloneConfig.Url,
-		Depth:         *cloneConfig.Depth,
-		ReferenceName: plumbing.ReferenceName(fmt.Sprintf("refs/heads/%s", *cloneConfig.Branch)),
-		SingleBranch:  true,
-		Tags:          git.NoTags,
-		Auth: &http.BasicAuth{
-			Username: *cloneConfig.Username,
-			Password: *cloneConfig.Token,
-		},
-	}
-
-	var repository *git.Repository
-	var err error
-	var dir string
-	if !*cloneConfig.InMemClone {
-		dir, err = ioutil.TempDir("", "gitrob")
-		if err != nil {
-			return nil, "", err
-		}
-		repository, err = git.PlainClone(dir, false, cloneOptions)
-	} else {
-		repository, err = git.Clone(memory.NewStorage(), nil, cloneOptions)
-	}
-	if err != nil {
-		return nil, dir, err
-	}
-	return repository, dir, nil
-
-}
+const MergeRequestRefSpec = "+refs/merge-requests/*/head:refs/merge-requests/*/head"
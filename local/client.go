@@ -0,0 +1,119 @@
+package local
+
+import (
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/codeEmitter/gitrob/common"
+	"gopkg.in/src-d/go-git.v4"
+)
+
+type Client struct{}
+
+func (c Client) NewClient() (apiClient Client) {
+	return c
+}
+
+func (c Client) GetUserOrOrganization(login string) (*common.Owner, error) {
+	path, err := filepath.Abs(login)
+	if err != nil {
+		return nil, err
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, errors.New(fmt.Sprintf("%s is not a directory", path))
+	}
+	id := pathID(path)
+	name := filepath.Base(path)
+	url := fileUrl(path)
+	targetType := common.TargetTypeDirectory
+	return &common.Owner{
+		Login: &path,
+		ID:    &id,
+		Type:  &targetType,
+		Name:  &name,
+		URL:   &url,
+	}, nil
+}
+
+func (c Client) GetRepositoriesFromOwner(target common.Owner) ([]*common.Repository, error) {
+	var allRepos []*common.Repository
+	if repo, err := openRepository(*target.Login); err == nil {
+		return append(allRepos, repo), nil
+	}
+	entries, err := ioutil.ReadDir(*target.Login)
+	if err != nil {
+		return nil, err
+	}
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+		repo, err := openRepository(filepath.Join(*target.Login, entry.Name()))
+		if err != nil {
+			continue
+		}
+		allRepos = append(allRepos, repo)
+	}
+	return allRepos, nil
+}
+
+func (c Client) GetOrganizationMembers(target common.Owner) ([]*common.Owner, error) {
+	return nil, nil
+}
+
+func (c Client) GetSubgroups(target common.Owner) ([]*common.Owner, error) {
+	return nil, nil
+}
+
+func openRepository(path string) (*common.Repository, error) {
+	repository, err := git.PlainOpen(path)
+	if err != nil {
+		return nil, err
+	}
+	defaultBranch := ""
+	var pushedAt *time.Time
+	if head, err := repository.Head(); err == nil {
+		defaultBranch = head.Name().Short()
+		//the last commit stands in for the last push, which local repositories don't record
+		if commit, err := repository.CommitObject(head.Hash()); err == nil {
+			pushedAt = &commit.Committer.When
+		}
+	}
+	id := pathID(path)
+	owner := filepath.Base(filepath.Dir(path))
+	name := filepath.Base(path)
+	url := fileUrl(path)
+	emptyString := ""
+	return &common.Repository{
+		Owner:         &owner,
+		ID:            &id,
+		Name:          &name,
+		FullName:      &path,
+		CloneURL:      &path,
+		URL:           &url,
+		DefaultBranch: &defaultBranch,
+		Description:   &emptyString,
+		Homepage:      &emptyString,
+		PushedAt:      pushedAt,
+	}, nil
+}
+
+// local directories have no numeric identity, so derive a stable one from the path
+func pathID(path string) int64 {
+	h := fnv.New64a()
+	h.Write([]byte(path))
+	return int64(h.Sum64())
+}
+
+func fileUrl(path string) string {
+	return "file://" + filepath.ToSlash(path)
+}
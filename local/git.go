@@ -0,0 +1,16 @@
+package local
+
+import (
+	"github.com/codeEmitter/gitrob/common"
+	"gopkg.in/src-d/go-git.v4"
+)
+
+// local repositories are opened in place; the returned path is always empty so
+// that the analysis cleanup never removes the user's checkout
+func CloneRepository(cloneConfig *common.CloneConfiguration) (*git.Repository, string, error) {
+	repository, err := git.PlainOpen(*cloneConfig.Url)
+	if err != nil {
+		return nil, "", err
+	}
+	return repository, "", nil
+}
@@ -3,6 +3,9 @@ package main
 import (
 	"fmt"
 	"os"
+	"os/signal"
+	"strings"
+	"syscall"
 	"time"
 
 	"github.com/codeEmitter/gitrob/common"
@@ -23,40 +26,115 @@ func main() {
 	sess.Out.Info("%s\n\n", common.ASCIIBanner)
 	sess.Out.Important("%s v%s started at %s\n", common.Name, common.Version, sess.Stats.StartedAt.Format(time.RFC3339))
 	sess.Out.Important("Loaded %d file signatures and %d content signatures.\n", len(sess.Signatures.FileSignatures), len(sess.Signatures.ContentSignatures))
-	sess.Out.Important("Web interface available at http://%s:%d\n", *sess.Options.BindAddress, *sess.Options.Port)
+	if !*sess.Options.Headless {
+		sess.Out.Important("Web interface available at http://%s:%d\n", *sess.Options.BindAddress, *sess.Options.Port)
+	}
 
 	if sess.Stats.Status == "finished" {
-		sess.Out.Important("Loaded session file: %s\n", *sess.Options.Load)
+		if *sess.Options.Load != "" {
+			sess.Out.Important("Loaded session file: %s\n", *sess.Options.Load)
+		} else {
+			sess.Out.Important("Loaded session database: %s\n", *sess.Options.Database)
+		}
 	} else {
 		if len(sess.Options.Logins) == 0 {
 			host := func() string {
-				if sess.IsGithubSession {
-					return "Github organization"
-				} else {
-					return "GitLab group"
+				if sess.IsLocalSession {
+					return "local repository path"
+				}
+				var hosts []string
+				if _, ok := sess.Providers[common.SourceTypeGithub]; ok {
+					hosts = append(hosts, "Github organization or user")
 				}
+				if _, ok := sess.Providers[common.SourceTypeGitLab]; ok {
+					hosts = append(hosts, "GitLab group or user")
+				}
+				if _, ok := sess.Providers[common.SourceTypeBitbucket]; ok {
+					hosts = append(hosts, "Bitbucket project or user")
+				}
+				return strings.Join(hosts, " or ")
 			}()
-			sess.Out.Fatal("Please provide at least one %s or user\n", host)
+			sess.Out.Fatal("Please provide at least one %s\n", host)
 		}
 
-		core.GatherTargets(sess)
-		core.GatherRepositories(sess)
+		interrupts := make(chan os.Signal, 1)
+		if sess.CheckpointsEnabled() || sess.Store != nil {
+			signal.Notify(interrupts, os.Interrupt, syscall.SIGTERM)
+			go func() {
+				<-interrupts
+				if sess.CheckpointsEnabled() {
+					sess.Out.Important("\nInterrupted, saving checkpoint...\n")
+					sess.SaveCheckpoint(true)
+				}
+				sess.Close()
+				os.Exit(1)
+			}()
+		}
+
+		if sess.Stats.Status == core.StatusAnalyzing {
+			//targets and repositories were gathered before the session was interrupted
+			sess.Out.Important("Resuming analysis of %d %s...\n", len(sess.Repositories),
+				common.Pluralize(len(sess.Repositories), "repository", "repositories"))
+		} else {
+			core.GatherTargets(sess)
+			core.GatherRepositories(sess)
+		}
 		core.AnalyzeRepositories(sess)
 		sess.Finish()
+		signal.Stop(interrupts)
+	}
 
-		if *sess.Options.Save != "" {
-			err := sess.SaveToFile(*sess.Options.Save)
-			if err != nil {
-				sess.Out.Error("Error saving session to %s: %s\n", *sess.Options.Save, err)
-			}
-			sess.Out.Important("Saved session to: %s\n\n", *sess.Options.Save)
+	//also exports sessions loaded from a database to the session file format
+	if *sess.Options.Save != "" {
+		err := sess.SaveToFile(*sess.Options.Save)
+		if err != nil {
+			sess.Out.Error("Error saving session to %s: %s\n", *sess.Options.Save, err)
+		}
+		sess.Out.Important("Saved session to: %s\n\n", *sess.Options.Save)
+	}
+
+	if *sess.Options.Report != "" {
+		err := sess.SaveReportToFile(*sess.Options.Report, *sess.Options.ReportFormat)
+		if err != nil {
+			sess.Out.Error("Error saving report to %s: %s\n", *sess.Options.Report, err)
+		} else {
+			sess.Out.Important("Saved %s report to: %s\n\n", *sess.Options.ReportFormat, *sess.Options.Report)
 		}
 	}
 
 	core.PrintSessionStats(sess)
-	if !sess.IsGithubSession {
+	var diff core.SessionDiff
+	if *sess.Options.Baseline != "" {
+		if diff, err = sess.Diff(); err != nil {
+			sess.Out.Fatal("Error comparing to baseline: %s\n", err)
+		}
+		core.PrintSessionDiff(sess, diff)
+	}
+	if *sess.Options.Headless {
+		//gate pipelines on findings at or above the configured severity, only new ones when comparing to a baseline
+		var count int
+		if *sess.Options.Baseline != "" {
+			count = diff.CountNewAtOrAbove(*sess.Options.FailSeverity)
+		} else if count, err = sess.CountFindingsAtOrAbove(*sess.Options.FailSeverity); err != nil {
+			sess.Out.Fatal("Error counting findings: %s\n", err)
+		}
+		sess.Close()
+		if count > 0 {
+			kind := "findings"
+			if *sess.Options.Baseline != "" {
+				kind = "new findings"
+			}
+			sess.Out.Error("%d %s at or above %s severity.\n", count, kind, *sess.Options.FailSeverity)
+			os.Exit(core.ExitCodeFindings)
+		}
+		os.Exit(0)
+	}
+	if _, ok := sess.Providers[common.SourceTypeGitLab]; ok {
 		sess.Out.Error("%s", common.GitLabTanuki)
 	}
 	sess.Out.Important("Press Ctrl+C to stop web server and exit.\n\n")
-	select {}
+	stop := make(chan os.Signal, 1)
+	signal.Notify(stop, os.Interrupt, syscall.SIGTERM)
+	<-stop
+	sess.Close()
 }
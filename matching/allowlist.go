@@ -0,0 +1,129 @@
+package matching
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+)
+
+// allowlists read from the working directory when none is given, in order of preference; .gitrob-baseline is
+// the name allowlists were first proposed under, before -baseline came to name the session findings are compared to
+var DefaultAllowlistFiles = []string{".gitrob-allowlist", ".gitrob-baseline"}
+
+var allowlistRuleKinds = struct {
+	Fingerprint string
+	Path        string
+	Repo        string
+	Signature   string
+}{
+	Fingerprint: "fingerprint",
+	Path:        "path",
+	Repo:        "repo",
+	Signature:   "signature",
+}
+
+// a single accepted finding, or kind of finding, of an allowlist file
+type AllowlistRule struct {
+	Kind  string
+	Value string
+	Line  int
+
+	glob *regexp.Regexp
+}
+
+// the rules of a .gitrob-allowlist file, one per line in the form kind: value, where the kind is one of
+// fingerprint, path (a glob), repo (a glob of owner/name) or signature (a signature description)
+type Allowlist struct {
+	Path  string
+	Rules []*AllowlistRule
+}
+
+func LoadAllowlist(path string) (*Allowlist, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	allowlist := &Allowlist{Path: path}
+	scanner := bufio.NewScanner(file)
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		rule, err := parseAllowlistRule(line, lineNumber)
+		if err != nil {
+			return nil, errors.New(fmt.Sprintf("Invalid rule on line %d of %s: %s", lineNumber, path, err))
+		}
+		allowlist.Rules = append(allowlist.Rules, rule)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return allowlist, nil
+}
+
+func parseAllowlistRule(line string, lineNumber int) (*AllowlistRule, error) {
+	separator := strings.Index(line, ":")
+	if separator < 0 {
+		return nil, errors.New("expected kind: value")
+	}
+	rule := &AllowlistRule{
+		Kind:  strings.ToLower(strings.TrimSpace(line[:separator])),
+		Value: strings.TrimSpace(line[separator+1:]),
+		Line:  lineNumber,
+	}
+	if rule.Value == "" {
+		return nil, errors.New(fmt.Sprintf("missing %s", rule.Kind))
+	}
+	switch rule.Kind {
+	case allowlistRuleKinds.Fingerprint, allowlistRuleKinds.Signature:
+	case allowlistRuleKinds.Path, allowlistRuleKinds.Repo:
+		glob, err := CompileGlob(rule.Value)
+		if err != nil {
+			return nil, err
+		}
+		rule.glob = glob
+	default:
+		return nil, errors.New(fmt.Sprintf("unknown kind '%s'", rule.Kind))
+	}
+	return rule, nil
+}
+
+func (r *AllowlistRule) Matches(finding *Finding) bool {
+	switch r.Kind {
+	case allowlistRuleKinds.Fingerprint:
+		return finding.Fingerprint == r.Value
+	case allowlistRuleKinds.Path:
+		return r.glob.MatchString(finding.FilePath)
+	case allowlistRuleKinds.Repo:
+		return r.glob.MatchString(finding.RepositoryOwner + "/" + finding.RepositoryName)
+	case allowlistRuleKinds.Signature:
+		return strings.EqualFold(finding.ContentSignatureDescription, r.Value) ||
+			strings.EqualFold(finding.FileSignatureDescription, r.Value)
+	}
+	return false
+}
+
+func (r *AllowlistRule) String() string {
+	return fmt.Sprintf("%s: %s", r.Kind, r.Value)
+}
+
+// returns the first rule accepting the finding, or nil when the finding is not accepted
+func (a *Allowlist) Match(finding *Finding) *AllowlistRule {
+	if a == nil {
+		return nil
+	}
+	for _, rule := range a.Rules {
+		if rule.Matches(finding) {
+			return rule
+		}
+	}
+	return nil
+}
@@ -1,15 +1,144 @@
 package matching
 
-import "regexp"
+import (
+	"crypto/sha1"
+	"errors"
+	"fmt"
+	"regexp"
+	"regexp/syntax"
+	"strings"
+	"unicode"
+)
+
+type ContentSignatureKind struct {
+	Regex   string
+	Entropy string
+}
+
+var contentSignatureKinds = ContentSignatureKind{
+	Regex:   "regex",
+	Entropy: "entropy",
+}
 
 type ContentSignature struct {
+	Kind        string
 	MatchOn     string
+	Charset     string
+	Threshold   float64
+	MinLength   int
 	Description string
 	Comment     string
+	Severity    string
+	regex       *regexp.Regexp
+	keywords    []string
+	literals    []string
+}
+
+func (c *ContentSignature) compile() error {
+	if c.Severity == "" {
+		c.Severity = DefaultSeverity
+	}
+	if err := ValidateSeverity(c.Severity); err != nil {
+		return err
+	}
+	switch c.Kind {
+	case "", contentSignatureKinds.Regex:
+	case contentSignatureKinds.Entropy:
+		if _, ok := entropyCharsets[c.Charset]; !ok {
+			return errors.New(fmt.Sprintf("Unrecognized 'Charset' parameter: %s", c.Charset))
+		}
+		if c.Threshold <= 0 || c.MinLength <= 0 {
+			return errors.New("'Threshold' and 'MinLength' must be greater than zero")
+		}
+		return nil
+	default:
+		return errors.New(fmt.Sprintf("Unrecognized 'Kind' parameter: %s", c.Kind))
+	}
+	regex, err := regexp.Compile(c.MatchOn)
+	if err != nil {
+		return err
+	}
+	c.regex = regex
+	c.keywords = requiredKeywords(c.MatchOn)
+	c.literals = patternLiterals(c.MatchOn)
+	return nil
+}
+
+// returns the text a pattern spells out literally, reduced to lowercase letters and digits
+func patternLiterals(pattern string) []string {
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return nil
+	}
+	var literals []string
+	var walk func(re *syntax.Regexp)
+	walk = func(re *syntax.Regexp) {
+		if re.Op == syntax.OpLiteral {
+			literals = append(literals, alphanumeric(string(re.Rune)))
+		}
+		for _, sub := range re.Sub {
+			walk(sub)
+		}
+	}
+	walk(re)
+	return literals
+}
+
+func alphanumeric(str string) string {
+	return strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return unicode.ToLower(r)
+		}
+		return -1
+	}, str)
+}
+
+// returns the secret fingerprints identify a match by. that is its longest token, unless the match spans
+// several lines, such as a whole private key, whose tokens may all be shared with other keys, or the token is
+// text the pattern spells out, such as the header of a private key, which every match shares. all of the match
+// identifies it in the first case, and the content it was found in, which holds the secret itself, in the second
+func (c ContentSignature) fingerprintSecret(text string, content string, literals []string) string {
+	if strings.Contains(text, "\n") {
+		return fmt.Sprintf("%x", sha1.Sum([]byte(text)))
+	}
+	secret := NormalizeSecret(text)
+	token := alphanumeric(secret)
+	isLiteral := token == ""
+	for _, literal := range literals {
+		if strings.Contains(literal, token) {
+			isLiteral = true
+			break
+		}
+	}
+	if isLiteral {
+		return fmt.Sprintf("%x", sha1.Sum([]byte(content)))
+	}
+	return secret
 }
 
-func (c ContentSignature) Match(target MatchTarget) (bool, error) {
-	return regexp.MatchString(c.MatchOn, target.Content)
+// returns the first match on each line of the target's content
+func (c ContentSignature) Match(target MatchTarget) ([]ContentMatch, error) {
+	if c.Kind == contentSignatureKinds.Entropy {
+		return c.matchEntropy(target), nil
+	}
+	regex, literals := c.regex, c.literals
+	if regex == nil {
+		var err error
+		if regex, err = regexp.Compile(c.MatchOn); err != nil {
+			return nil, err
+		}
+		literals = patternLiterals(c.MatchOn)
+	}
+	var matches []ContentMatch
+	for _, location := range regex.FindAllStringIndex(target.Content, -1) {
+		match := target.newContentMatch(location[0], location[1])
+		if len(matches) > 0 && matches[len(matches)-1].isSameLine(match) {
+			continue
+		}
+		match.Secret = c.fingerprintSecret(match.Text, target.Content, literals)
+		matches = append(matches, match)
+	}
+	return matches, nil
 }
 
 func (c ContentSignature) GetDescription() string {
@@ -0,0 +1,75 @@
+package matching
+
+import (
+	"math"
+	"strings"
+)
+
+const (
+	Base64Charset = "ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789+/="
+	HexCharset    = "0123456789abcdefABCDEF"
+)
+
+var entropyCharsets = map[string]string{
+	"base64": Base64Charset,
+	"hex":    HexCharset,
+}
+
+// returns the Shannon entropy of the string in bits per character
+func ShannonEntropy(str string) float64 {
+	if str == "" {
+		return 0
+	}
+	frequencies := make(map[rune]float64)
+	length := 0.0
+	for _, r := range str {
+		frequencies[r]++
+		length++
+	}
+	entropy := 0.0
+	for _, frequency := range frequencies {
+		probability := frequency / length
+		entropy -= probability * math.Log2(probability)
+	}
+	return entropy
+}
+
+// returns the offsets of every run of charset characters at least minLength long in the string
+func tokenLocations(str string, charset string, minLength int) [][]int {
+	var locations [][]int
+	start := -1
+	for i := 0; i <= len(str); i++ {
+		if i < len(str) && strings.IndexByte(charset, str[i]) >= 0 {
+			if start == -1 {
+				start = i
+			}
+			continue
+		}
+		if start != -1 && i-start >= minLength {
+			locations = append(locations, []int{start, i})
+		}
+		start = -1
+	}
+	return locations
+}
+
+// flags the first token on each scanned line whose entropy exceeds the signature's threshold
+func (c ContentSignature) matchEntropy(target MatchTarget) []ContentMatch {
+	charset := entropyCharsets[c.Charset]
+	var matches []ContentMatch
+	for i, index := range target.lineIndexes {
+		line := target.Lines[index].Content
+		for _, location := range tokenLocations(line, charset, c.MinLength) {
+			entropy := ShannonEntropy(line[location[0]:location[1]])
+			if entropy <= c.Threshold {
+				continue
+			}
+			match := target.newContentMatch(target.lineOffsets[i]+location[0], target.lineOffsets[i]+location[1])
+			match.Entropy = entropy
+			match.Secret = NormalizeSecret(match.Text)
+			matches = append(matches, match)
+			break
+		}
+	}
+	return matches
+}
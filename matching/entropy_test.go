@@ -0,0 +1,101 @@
+package matching
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/codeEmitter/gitrob/common"
+	"gopkg.in/src-d/go-git.v4/plumbing/format/diff"
+)
+
+func TestShannonEntropy(t *testing.T) {
+	tests := []struct {
+		str     string
+		entropy float64
+	}{
+		{"", 0},
+		{"aaaaaaaa", 0},
+		{"abababab", 1},
+		{"aabb", 1},
+		{"abcd", 2},
+		{"0123456789abcdef", 4},
+		{"aaab", 0.8112781244591328},
+	}
+	for _, test := range tests {
+		if entropy := ShannonEntropy(test.str); math.Abs(entropy-test.entropy) > 1e-9 {
+			t.Errorf("ShannonEntropy(%q) = %v, want %v", test.str, entropy, test.entropy)
+		}
+	}
+}
+
+func TestTokenLocations(t *testing.T) {
+	tests := []struct {
+		str       string
+		charset   string
+		minLength int
+		locations [][]int
+	}{
+		{"token 0123456789abcdef0123 end", HexCharset, 20, [][]int{{6, 26}}},
+		//one character short of the minimum length
+		{"token 0123456789abcdef012 end", HexCharset, 20, nil},
+		//letters past f end hex tokens but not base64 ones
+		{"token zyx0123456789abcdef0123 end", HexCharset, 20, [][]int{{9, 29}}},
+		{"token zyx0123456789abcdef0123 end", Base64Charset, 20, [][]int{{6, 29}}},
+		{"key=a/b+c==", Base64Charset, 6, [][]int{{0, 11}}},
+		{"key=a/b+c==", HexCharset, 6, nil},
+		{"0123456789 abcdef 0123456789", HexCharset, 6, [][]int{{0, 10}, {11, 17}, {18, 28}}},
+	}
+	for _, test := range tests {
+		if locations := tokenLocations(test.str, test.charset, test.minLength); !reflect.DeepEqual(locations, test.locations) {
+			t.Errorf("tokenLocations(%q, %q, %d) = %v, want %v", test.str, test.charset, test.minLength, locations, test.locations)
+		}
+	}
+}
+
+func TestMatchEntropy(t *testing.T) {
+	signatures := map[string]ContentSignature{
+		"base64": {Kind: contentSignatureKinds.Entropy, Charset: "base64", Threshold: 4.5, MinLength: 20},
+		"hex":    {Kind: contentSignatureKinds.Entropy, Charset: "hex", Threshold: 3.0, MinLength: 20},
+	}
+	tests := []struct {
+		charset string
+		line    string
+		match   string
+	}{
+		{"hex", "key: 3f9a0c5b7e21d48f6a0b9c3e5d7f1a2b", "3f9a0c5b7e21d48f6a0b9c3e5d7f1a2b"},
+		{"base64", "secret: q7Xz9Lk2Vb8Np4Rt6Wm1Hs3Jd5Fg0Ce", "q7Xz9Lk2Vb8Np4Rt6Wm1Hs3Jd5Fg0Ce"},
+		//the first random token of a line is flagged, after tokens of low entropy
+		{"hex", "0000000000000000000000 3f9a0c5b7e21d48f6a0b9c3e5d7f1a2b", "3f9a0c5b7e21d48f6a0b9c3e5d7f1a2b"},
+		//long but repetitive strings are not secrets
+		{"hex", "padding: 00000000000000000000000000000000", ""},
+		{"hex", "color: abababababababababababababab", ""},
+		{"base64", "AAAAAAAAAABBBBBBBBBBCCCCCCCCCCDDDDDDDDDD", ""},
+		//hex digests are not random enough for the base64 threshold
+		{"base64", "sha: 3f9a0c5b7e21d48f6a0b9c3e5d7f1a2b", ""},
+		//random but too short
+		{"hex", "id: 3f9a0c5b7e21d48f6a0", ""},
+	}
+	for _, test := range tests {
+		signature := signatures[test.charset]
+		if err := signature.compile(); err != nil {
+			t.Fatal(err)
+		}
+		target := NewMatchTarget("config.yml")
+		target.SetLines([]common.ChangeLine{{Operation: diff.Add, Content: test.line, NewLineNumber: 1}}, false)
+		matches, err := signature.Match(target)
+		if err != nil {
+			t.Fatal(err)
+		}
+		match := ""
+		if len(matches) > 0 {
+			match = matches[0].Text
+		}
+		if len(matches) > 1 || match != test.match {
+			t.Errorf("matchEntropy(%s, %q) = %d matches, first %q, want %q", test.charset, test.line, len(matches), match, test.match)
+		}
+		if match != "" && matches[0].Entropy <= signature.Threshold {
+			t.Errorf("matchEntropy(%s, %q) reports entropy %v, want above %v", test.charset, test.line, matches[0].Entropy, signature.Threshold)
+		}
+	}
+}
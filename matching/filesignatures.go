@@ -23,6 +23,28 @@ type FileSignature struct {
 	MatchOn     string
 	Description string
 	Comment     string
+	Severity    string
+	regex       *regexp.Regexp
+}
+
+func (f *FileSignature) compile() error {
+	if f.Severity == "" {
+		f.Severity = DefaultSeverity
+	}
+	if err := ValidateSeverity(f.Severity); err != nil {
+		return err
+	}
+	switch f.Part {
+	case fileSignatureTypes.Path, fileSignatureTypes.Filename, fileSignatureTypes.Extension:
+	default:
+		return errors.New(fmt.Sprintf("Unrecognized 'Part' parameter: %s", f.Part))
+	}
+	regex, err := regexp.Compile(f.MatchOn)
+	if err != nil {
+		return err
+	}
+	f.regex = regex
+	return nil
 }
 
 func (f FileSignature) Match(target MatchTarget) (bool, error) {
@@ -35,9 +57,12 @@ func (f FileSignature) Match(target MatchTarget) (bool, error) {
 	case fileSignatureTypes.Extension:
 		haystack = &target.Extension
 	default:
-		return false, errors.New(fmt.Sprintf("Unrecognized 'Part' parameter: %f\n", f.Part))
+		return false, errors.New(fmt.Sprintf("Unrecognized 'Part' parameter: %s\n", f.Part))
+	}
+	if f.regex == nil {
+		return regexp.MatchString(f.MatchOn, *haystack)
 	}
-	return regexp.MatchString(f.MatchOn, *haystack)
+	return f.regex.MatchString(*haystack), nil
 }
 
 func (f FileSignature) GetDescription() string {
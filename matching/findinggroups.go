@@ -0,0 +1,66 @@
+package matching
+
+import "time"
+
+// the occurrences of a single secret, as identified by its fingerprint, across commits and repositories
+type FindingGroup struct {
+	Fingerprint                 string
+	FileSignatureDescription    string
+	ContentSignatureDescription string
+	Severity                    string
+	RepositoryOwner             string
+	RepositoryName              string
+	FilePath                    string
+	Secret                      string
+	FirstSeenCommit             string
+	FirstSeenDate               time.Time
+	LastSeenCommit              string
+	LastSeenDate                time.Time
+	Occurrences                 int
+	FindingIds                  []string
+}
+
+func NewFindingGroup(finding *Finding) *FindingGroup {
+	group := &FindingGroup{
+		Fingerprint:                 finding.Fingerprint,
+		FileSignatureDescription:    finding.FileSignatureDescription,
+		ContentSignatureDescription: finding.ContentSignatureDescription,
+		Severity:                    finding.Severity,
+		RepositoryOwner:             finding.RepositoryOwner,
+		RepositoryName:              finding.RepositoryName,
+		FilePath:                    finding.FilePath,
+		Secret:                      finding.Secret,
+		FirstSeenCommit:             finding.CommitHash,
+		FirstSeenDate:               finding.CommitDate,
+		LastSeenCommit:              finding.CommitHash,
+		LastSeenDate:                finding.CommitDate,
+	}
+	group.Add(finding)
+	return group
+}
+
+func (g *FindingGroup) Contains(findingId string) bool {
+	for _, id := range g.FindingIds {
+		if id == findingId {
+			return true
+		}
+	}
+	return false
+}
+
+// records another occurrence, widening the first and last seen commits when it falls outside them
+func (g *FindingGroup) Add(finding *Finding) {
+	if g.Contains(finding.Id) {
+		return
+	}
+	g.FindingIds = append(g.FindingIds, finding.Id)
+	g.Occurrences++
+	if finding.CommitDate.Before(g.FirstSeenDate) {
+		g.FirstSeenCommit = finding.CommitHash
+		g.FirstSeenDate = finding.CommitDate
+	}
+	if finding.CommitDate.After(g.LastSeenDate) {
+		g.LastSeenCommit = finding.CommitHash
+		g.LastSeenDate = finding.CommitDate
+	}
+}
@@ -5,8 +5,26 @@ import (
 	"fmt"
 	"github.com/codeEmitter/gitrob/common"
 	"io"
+	"path/filepath"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
 )
 
+var pullRequestRefRegex = regexp.MustCompile(`^refs/(pull|merge-requests|pull-requests)/(\d+)/(head|from)$`)
+
+// quotes and delimiters that patterns commonly capture around a secret
+const secretDelimiters = " \t\r\n\"'`,;()[]{}<>"
+
+// operators separating the name a secret is assigned to from the secret
+const assignmentOperators = "=:"
+
+type PullRequest struct {
+	Number int
+	Url    string
+}
+
 type Finding struct {
 	Id                          string
 	FilePath                    string
@@ -15,30 +33,84 @@ type Finding struct {
 	FileSignatureComment        string
 	ContentSignatureDescription string
 	ContentSignatureComment     string
+	Severity                    string
 	RepositoryOwner             string
 	RepositoryName              string
+	RepositoryId                int64
 	CommitHash                  string
+	CommitDate                  time.Time
 	CommitMessage               string
 	CommitAuthor                string
 	FileUrl                     string
 	CommitUrl                   string
 	RepositoryUrl               string
 	CloneUrl                    string
+	Refs                        []string
+	PullRequests                []PullRequest
+	LineNumber                  int
+	OldLineNumber               int
+	Removed                     bool
+	Secret                      string
+	Entropy                     float64
+	Context                     []string
+	Fingerprint                 string
+	SuppressedBy                string
+	SourceType                  string
 }
 
-func (f *Finding) setupUrls(isGithubSession bool) {
-	if isGithubSession {
-		f.RepositoryUrl = fmt.Sprintf("https://github.com/%s/%s", f.RepositoryOwner, f.RepositoryName)
+func (f *Finding) setupUrls(sourceType string, webUrl string) {
+	switch sourceType {
+	case common.SourceTypeLocal:
+		//local files are served by the web interface itself rather than a code host
+		f.RepositoryUrl = fmt.Sprintf("file://%s", filepath.ToSlash(f.CloneUrl))
+		f.FileUrl = fmt.Sprintf("/files/%s/%s/%s/%s?repository=%d", f.RepositoryOwner, f.RepositoryName, f.CommitHash, f.FilePath, f.RepositoryId)
+		f.CommitUrl = ""
+	case common.SourceTypeGithub:
+		f.RepositoryUrl = fmt.Sprintf("%s/%s/%s", webUrl, f.RepositoryOwner, f.RepositoryName)
 		f.FileUrl = fmt.Sprintf("%s/blob/%s/%s", f.RepositoryUrl, f.CommitHash, f.FilePath)
 		f.CommitUrl = fmt.Sprintf("%s/commit/%s", f.RepositoryUrl, f.CommitHash)
-	} else {
+	case common.SourceTypeBitbucket:
+		f.RepositoryUrl = fmt.Sprintf("%s/%s", webUrl, common.BitbucketRepositoryPath(f.RepositoryOwner, f.RepositoryName))
+		f.FileUrl = fmt.Sprintf("%s/browse/%s?at=%s", f.RepositoryUrl, f.FilePath, f.CommitHash)
+		f.CommitUrl = fmt.Sprintf("%s/commits/%s", f.RepositoryUrl, f.CommitHash)
+	default:
 		results := common.CleanUrlSpaces(f.RepositoryOwner, f.RepositoryName)
-		f.RepositoryUrl = fmt.Sprintf("https://gitlab.com/%s/%s", results[0], results[1])
-		f.FileUrl = fmt.Sprintf("%s/blob/%s/%s", f.RepositoryUrl, f.CommitHash, f.FilePath)
-		f.CommitUrl = fmt.Sprintf("%s/commit/%s", f.RepositoryUrl, f.CommitHash)
+		f.RepositoryUrl = fmt.Sprintf("%s/%s/%s", webUrl, results[0], results[1])
+		f.FileUrl = fmt.Sprintf("%s/-/blob/%s/%s", f.RepositoryUrl, f.CommitHash, f.FilePath)
+		f.CommitUrl = fmt.Sprintf("%s/-/commit/%s", f.RepositoryUrl, f.CommitHash)
 	}
 }
 
+func (f *Finding) setupPullRequests(sourceType string) {
+	for _, ref := range f.Refs {
+		matches := pullRequestRefRegex.FindStringSubmatch(ref)
+		if matches == nil {
+			continue
+		}
+		number, _ := strconv.Atoi(matches[2])
+		pullRequest := PullRequest{Number: number}
+		switch sourceType {
+		case common.SourceTypeGithub:
+			pullRequest.Url = fmt.Sprintf("%s/pull/%d", f.RepositoryUrl, number)
+		case common.SourceTypeGitLab:
+			pullRequest.Url = fmt.Sprintf("%s/-/merge_requests/%d", f.RepositoryUrl, number)
+		case common.SourceTypeBitbucket:
+			pullRequest.Url = fmt.Sprintf("%s/pull-requests/%d", f.RepositoryUrl, number)
+		}
+		f.PullRequests = append(f.PullRequests, pullRequest)
+	}
+}
+
+func (f *Finding) DescribeLine() string {
+	if f.Removed {
+		return fmt.Sprintf("%d (removed in commit %s)", f.OldLineNumber, f.CommitHash)
+	}
+	if f.LineNumber == 0 {
+		return fmt.Sprintf("%d (old file)", f.OldLineNumber)
+	}
+	return strconv.Itoa(f.LineNumber)
+}
+
 func (f *Finding) generateID() {
 	h := sha1.New()
 	io.WriteString(h, f.FilePath)
@@ -48,10 +120,67 @@ func (f *Finding) generateID() {
 	io.WriteString(h, f.CommitHash)
 	io.WriteString(h, f.CommitMessage)
 	io.WriteString(h, f.CommitAuthor)
+	io.WriteString(h, f.FileSignatureDescription)
+	io.WriteString(h, f.ContentSignatureDescription)
+	io.WriteString(h, strconv.Itoa(f.LineNumber))
+	io.WriteString(h, strconv.Itoa(f.OldLineNumber))
 	f.Id = fmt.Sprintf("%x", h.Sum(nil))
 }
 
-func (f *Finding) Initialize(isGithubSession bool) {
-	f.setupUrls(isGithubSession)
+// identifies the secret independently of the commit it was found in, so that occurrences of the same
+// secret across commits and repositories can be grouped; findings without a secret are identified by
+// their file, and includePath distinguishes the same secret committed to different paths
+func (f *Finding) GenerateFingerprint(secret string, includePath bool) {
+	h := sha1.New()
+	io.WriteString(h, f.FileSignatureDescription)
+	io.WriteString(h, f.ContentSignatureDescription)
+	secret = NormalizeSecret(secret)
+	if secret == "" {
+		io.WriteString(h, f.RepositoryOwner)
+		io.WriteString(h, f.RepositoryName)
+		includePath = true
+	}
+	io.WriteString(h, secret)
+	if includePath {
+		io.WriteString(h, f.FilePath)
+	}
+	f.Fingerprint = fmt.Sprintf("%x", h.Sum(nil))
+}
+
+// reduces the text a content signature matched to the secret itself, taken to be its longest token, leaving
+// out the names, operators and quotes patterns match around it so that the same secret assigned to different
+// names is identified as one
+func NormalizeSecret(match string) string {
+	secret := ""
+	for _, token := range strings.FieldsFunc(match, func(r rune) bool {
+		return strings.ContainsRune(secretDelimiters+assignmentOperators, r)
+	}) {
+		if len(token) > len(secret) {
+			secret = token
+		}
+	}
+	return secret
+}
+
+// returns the code host the finding came from, telling it from its URLs for sessions saved before findings
+// recorded it. older sessions linked GitLab commits like GitHub ones, so those are told by the GitLab web URL
+func (f *Finding) GetSourceType(gitLabWebUrl string) string {
+	switch {
+	case f.SourceType != "":
+		return f.SourceType
+	case strings.HasPrefix(f.FileUrl, "/files/"):
+		return common.SourceTypeLocal
+	case strings.Contains(f.CommitUrl, "/-/commit/"):
+		return common.SourceTypeGitLab
+	case gitLabWebUrl != "" && strings.HasPrefix(f.CommitUrl, strings.TrimSuffix(gitLabWebUrl, "/")+"/"):
+		return common.SourceTypeGitLab
+	}
+	return common.SourceTypeGithub
+}
+
+func (f *Finding) Initialize(sourceType string, webUrl string) {
+	f.SourceType = sourceType
+	f.setupUrls(sourceType, webUrl)
+	f.setupPullRequests(sourceType)
 	f.generateID()
 }
@@ -0,0 +1,62 @@
+package matching
+
+import (
+	"regexp"
+	"strings"
+)
+
+// compiles a path glob to a regular expression, where * and ? match within a path segment and ** matches
+// across segments; a glob without a slash matches the last segment of a path, like in .gitignore files
+func CompileGlob(glob string) (*regexp.Regexp, error) {
+	return regexp.Compile(globExpression(glob))
+}
+
+// like CompileGlob, but letters match regardless of case, such as Node_Modules for node_modules
+func CompileGlobIgnoringCase(glob string) (*regexp.Regexp, error) {
+	return regexp.Compile("(?i)" + globExpression(glob))
+}
+
+func globExpression(glob string) string {
+	var builder strings.Builder
+	if !strings.Contains(glob, "/") {
+		builder.WriteString("(^|/)")
+	} else {
+		builder.WriteString("^")
+		glob = strings.TrimPrefix(glob, "/")
+	}
+	for i := 0; i < len(glob); i++ {
+		switch c := glob[i]; c {
+		case '*':
+			if i+1 < len(glob) && glob[i+1] == '*' {
+				i++
+				//**/ also matches no segment at all, so that a/**/b matches a/b
+				if i+1 < len(glob) && glob[i+1] == '/' {
+					i++
+					builder.WriteString("(.*/)?")
+				} else {
+					builder.WriteString(".*")
+				}
+			} else {
+				builder.WriteString("[^/]*")
+			}
+		case '?':
+			builder.WriteString("[^/]")
+		case '[':
+			end := strings.IndexByte(glob[i:], ']')
+			if end < 0 {
+				builder.WriteString(regexp.QuoteMeta(string(c)))
+				continue
+			}
+			class := glob[i+1 : i+end]
+			if strings.HasPrefix(class, "!") {
+				class = "^" + class[1:]
+			}
+			builder.WriteString("[" + class + "]")
+			i += end
+		default:
+			builder.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	builder.WriteString("$")
+	return builder.String()
+}
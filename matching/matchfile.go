@@ -2,33 +2,100 @@ package matching
 
 import (
 	"path/filepath"
+	"sort"
 	"strings"
+
+	"github.com/codeEmitter/gitrob/common"
+	"gopkg.in/src-d/go-git.v4/plumbing/format/diff"
 )
 
+const ContextLines = 2
+
 type MatchTarget struct {
 	Path      string
 	Filename  string
 	Extension string
 	Content   string
+	Lines     []common.ChangeLine
+
+	lineOffsets []int
+	lineIndexes []int
 }
 
-var skippableExtensions = []string{".jpg", ".jpeg", ".png", ".gif", ".bmp", ".tiff", ".tif", ".psd", ".xcf"}
-var skippablePathIndicators = []string{"node_modules/", "vendor/bundle", "vendor/cache"}
+type ContentMatch struct {
+	Text          string
+	Secret        string //the text fingerprints identify the match by
+	OldLineNumber int
+	NewLineNumber int
+	Removed       bool
+	Context       []string
+	Entropy       float64
+}
 
-func (f *MatchTarget) IsSkippable() bool {
-	ext := strings.ToLower(f.Extension)
-	path := strings.ToLower(f.Path)
-	for _, skippableExt := range skippableExtensions {
-		if ext == skippableExt {
-			return true
+// sets the lines of the change and builds the content searched by content signatures from its added lines,
+// and also from its removed lines when includeRemoved is set; all lines remain available as context
+func (f *MatchTarget) SetLines(lines []common.ChangeLine, includeRemoved bool) {
+	f.Lines = lines
+	f.lineOffsets = nil
+	f.lineIndexes = nil
+	var builder strings.Builder
+	for i, line := range lines {
+		if line.Operation != diff.Add && !(includeRemoved && line.Operation == diff.Delete) {
+			continue
 		}
+		f.lineOffsets = append(f.lineOffsets, builder.Len())
+		f.lineIndexes = append(f.lineIndexes, i)
+		builder.WriteString(line.Content)
+		builder.WriteString("\n")
 	}
-	for _, skippablePathIndicator := range skippablePathIndicators {
-		if strings.Contains(path, skippablePathIndicator) {
-			return true
-		}
+	f.Content = builder.String()
+}
+
+// describes the content between the given offsets by the line it starts on and its surrounding lines
+func (f *MatchTarget) newContentMatch(start int, end int) ContentMatch {
+	//patterns may consume the whitespace delimiting a secret, including line breaks
+	match := ContentMatch{Text: strings.TrimSpace(f.Content[start:end])}
+	if len(f.lineOffsets) == 0 {
+		return match
+	}
+	index := sort.Search(len(f.lineOffsets), func(i int) bool {
+		return f.lineOffsets[i] > start
+	}) - 1
+	if index < 0 {
+		index = 0
+	}
+	index = f.lineIndexes[index]
+	line := f.Lines[index]
+	match.OldLineNumber = line.OldLineNumber
+	match.NewLineNumber = line.NewLineNumber
+	match.Removed = line.Operation == diff.Delete
+	from := index - ContextLines
+	if from < 0 {
+		from = 0
+	}
+	to := index + ContextLines + 1
+	if to > len(f.Lines) {
+		to = len(f.Lines)
+	}
+	for _, contextLine := range f.Lines[from:to] {
+		match.Context = append(match.Context, formatContextLine(contextLine))
+	}
+	return match
+}
+
+func (m ContentMatch) isSameLine(other ContentMatch) bool {
+	return m.OldLineNumber == other.OldLineNumber && m.NewLineNumber == other.NewLineNumber
+}
+
+func formatContextLine(line common.ChangeLine) string {
+	switch line.Operation {
+	case diff.Add:
+		return "+" + line.Content
+	case diff.Delete:
+		return "-" + line.Content
+	default:
+		return " " + line.Content
 	}
-	return false
 }
 
 func NewMatchTarget(path string) MatchTarget {
@@ -0,0 +1,87 @@
+package matching
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/codeEmitter/gitrob/common"
+	"gopkg.in/src-d/go-git.v4/plumbing/format/diff"
+)
+
+// a change removing a line and adding lines around an unchanged one, starting and ending with changed lines
+var testChangeLines = []common.ChangeLine{
+	{Operation: diff.Add, Content: "first", NewLineNumber: 1},
+	{Operation: diff.Equal, Content: "unchanged", OldLineNumber: 1, NewLineNumber: 2},
+	{Operation: diff.Delete, Content: "removed", OldLineNumber: 2},
+	{Operation: diff.Add, Content: "middle", NewLineNumber: 3},
+	{Operation: diff.Equal, Content: "unchanged again", OldLineNumber: 3, NewLineNumber: 4},
+	{Operation: diff.Add, Content: "last", NewLineNumber: 5},
+}
+
+// the match of the first occurrence of the text in the content built from the lines
+func matchText(t *testing.T, target *MatchTarget, text string) ContentMatch {
+	start := strings.Index(target.Content, text)
+	if start == -1 {
+		t.Fatalf("%q is not in the content %q", text, target.Content)
+	}
+	return target.newContentMatch(start, start+len(text))
+}
+
+func TestSetLinesBuildsContentFromAddedLines(t *testing.T) {
+	tests := []struct {
+		includeRemoved bool
+		content        string
+	}{
+		{false, "first\nmiddle\nlast\n"},
+		{true, "first\nremoved\nmiddle\nlast\n"},
+	}
+	for _, test := range tests {
+		target := NewMatchTarget("config.yml")
+		target.SetLines(testChangeLines, test.includeRemoved)
+		if target.Content != test.content {
+			t.Errorf("SetLines(include removed %t) content = %q, want %q", test.includeRemoved, target.Content, test.content)
+		}
+	}
+}
+
+func TestContentMatchLineNumbersAndContext(t *testing.T) {
+	tests := []struct {
+		text          string
+		oldLineNumber int
+		newLineNumber int
+		removed       bool
+		context       []string
+	}{
+		//the context window is cut short at the start and end of the change
+		{"first", 0, 1, false, []string{"+first", " unchanged", "-removed"}},
+		{"removed", 2, 0, true, []string{"+first", " unchanged", "-removed", "+middle", " unchanged again"}},
+		{"middle", 0, 3, false, []string{" unchanged", "-removed", "+middle", " unchanged again", "+last"}},
+		{"last", 0, 5, false, []string{"+middle", " unchanged again", "+last"}},
+		//a match spanning lines is reported on the line it starts on
+		{"middle\nlast", 0, 3, false, []string{" unchanged", "-removed", "+middle", " unchanged again", "+last"}},
+	}
+	target := NewMatchTarget("config.yml")
+	target.SetLines(testChangeLines, true)
+	for _, test := range tests {
+		match := matchText(t, &target, test.text)
+		if match.OldLineNumber != test.oldLineNumber || match.NewLineNumber != test.newLineNumber || match.Removed != test.removed {
+			t.Errorf("match of %q is on old line %d, new line %d, removed %t, want %d, %d, %t", test.text,
+				match.OldLineNumber, match.NewLineNumber, match.Removed, test.oldLineNumber, test.newLineNumber, test.removed)
+		}
+		if strings.Join(match.Context, "\n") != strings.Join(test.context, "\n") {
+			t.Errorf("context of %q = %q, want %q", test.text, match.Context, test.context)
+		}
+	}
+}
+
+func TestContentMatchOfASingleLine(t *testing.T) {
+	target := NewMatchTarget("config.yml")
+	target.SetLines([]common.ChangeLine{{Operation: diff.Add, Content: " secret ", NewLineNumber: 1}}, false)
+	match := target.newContentMatch(0, len(target.Content))
+	if match.Text != "secret" || match.NewLineNumber != 1 {
+		t.Errorf("match of the whole content = %q on line %d, want %q on line 1", match.Text, match.NewLineNumber, "secret")
+	}
+	if strings.Join(match.Context, "\n") != "+ secret " {
+		t.Errorf("context of the only line = %q, want %q", match.Context, []string{"+ secret "})
+	}
+}
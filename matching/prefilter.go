@@ -0,0 +1,330 @@
+package matching
+
+import (
+	"regexp/syntax"
+	"strings"
+	"unicode"
+)
+
+const (
+	minKeywordLength = 3
+	maxKeywordCount  = 64
+)
+
+// a multi-pattern (Aho-Corasick) search over the literal keywords that content signatures
+// require, used to skip signatures whose keywords do not occur in the content at all
+type prefilter struct {
+	nodes      []prefilterNode
+	keywordIDs map[string]int
+	keywords   [][]int
+	unfiltered []bool
+}
+
+type prefilterNode struct {
+	next     map[byte]int
+	fail     int
+	keywords []int
+}
+
+func newPrefilter(signatures []ContentSignature) *prefilter {
+	p := &prefilter{
+		nodes:      []prefilterNode{{next: map[byte]int{}}},
+		keywordIDs: map[string]int{},
+		keywords:   make([][]int, len(signatures)),
+		unfiltered: make([]bool, len(signatures)),
+	}
+	for i, signature := range signatures {
+		if len(signature.keywords) == 0 {
+			p.unfiltered[i] = true
+			continue
+		}
+		for _, keyword := range signature.keywords {
+			p.keywords[i] = append(p.keywords[i], p.addKeyword(keyword))
+		}
+	}
+	p.buildFailureLinks()
+	return p
+}
+
+func (p *prefilter) addKeyword(keyword string) int {
+	if id, ok := p.keywordIDs[keyword]; ok {
+		return id
+	}
+	id := len(p.keywordIDs)
+	p.keywordIDs[keyword] = id
+	node := 0
+	for i := 0; i < len(keyword); i++ {
+		next, ok := p.nodes[node].next[keyword[i]]
+		if !ok {
+			next = len(p.nodes)
+			p.nodes = append(p.nodes, prefilterNode{next: map[byte]int{}})
+			p.nodes[node].next[keyword[i]] = next
+		}
+		node = next
+	}
+	p.nodes[node].keywords = append(p.nodes[node].keywords, id)
+	return id
+}
+
+func (p *prefilter) buildFailureLinks() {
+	var queue []int
+	for _, child := range p.nodes[0].next {
+		queue = append(queue, child)
+	}
+	for len(queue) > 0 {
+		node := queue[0]
+		queue = queue[1:]
+		for b, child := range p.nodes[node].next {
+			fail := p.nodes[node].fail
+			for fail != 0 && !p.hasTransition(fail, b) {
+				fail = p.nodes[fail].fail
+			}
+			if next, ok := p.nodes[fail].next[b]; ok && next != child {
+				p.nodes[child].fail = next
+			}
+			failNode := p.nodes[child].fail
+			p.nodes[child].keywords = append(p.nodes[child].keywords, p.nodes[failNode].keywords...)
+			queue = append(queue, child)
+		}
+	}
+}
+
+func (p *prefilter) hasTransition(node int, b byte) bool {
+	_, ok := p.nodes[node].next[b]
+	return ok
+}
+
+// reports, for each signature, whether it could match the content
+func (p *prefilter) candidates(content string) []bool {
+	found := make([]bool, len(p.keywordIDs))
+	content = strings.ToLower(content)
+	node := 0
+	for i := 0; i < len(content); i++ {
+		b := content[i]
+		for node != 0 && !p.hasTransition(node, b) {
+			node = p.nodes[node].fail
+		}
+		if next, ok := p.nodes[node].next[b]; ok {
+			node = next
+		}
+		for _, id := range p.nodes[node].keywords {
+			found[id] = true
+		}
+	}
+	candidates := make([]bool, len(p.keywords))
+	for i, ids := range p.keywords {
+		candidates[i] = p.unfiltered[i]
+		for _, id := range ids {
+			if found[id] {
+				candidates[i] = true
+				break
+			}
+		}
+	}
+	return candidates
+}
+
+// derives a set of lowercase literals of which at least one occurs in any text the pattern
+// matches; nil means no useful set could be derived and the pattern must always be run
+func requiredKeywords(pattern string) []string {
+	re, err := syntax.Parse(pattern, syntax.Perl)
+	if err != nil {
+		return nil
+	}
+	keywords := requiredLiterals(re.Simplify())
+	if len(keywords) == 0 || len(keywords) > maxKeywordCount {
+		return nil
+	}
+	for _, keyword := range keywords {
+		if len(keyword) < minKeywordLength {
+			return nil
+		}
+	}
+	return withoutRedundant(keywords)
+}
+
+// leaves out keywords containing another keyword of the set, which occurs wherever they do
+func withoutRedundant(keywords []string) []string {
+	var kept []string
+	for i, keyword := range keywords {
+		redundant := false
+		for j, other := range keywords {
+			if i != j && other != keyword && strings.Contains(keyword, other) {
+				redundant = true
+				break
+			}
+		}
+		if !redundant {
+			kept = append(kept, keyword)
+		}
+	}
+	return kept
+}
+
+func requiredLiterals(re *syntax.Regexp) []string {
+	if exact := exactLiterals(re); exact != nil && shortestLength(exact) > 0 {
+		return exact
+	}
+	switch re.Op {
+	case syntax.OpCapture, syntax.OpPlus:
+		return requiredLiterals(re.Sub[0])
+	case syntax.OpRepeat:
+		if re.Min > 0 {
+			return requiredLiterals(re.Sub[0])
+		}
+	case syntax.OpAlternate:
+		var literals []string
+		for _, sub := range re.Sub {
+			subLiterals := requiredLiterals(sub)
+			if subLiterals == nil {
+				return nil
+			}
+			literals = append(literals, subLiterals...)
+		}
+		return dedupe(literals)
+	case syntax.OpConcat:
+		var best []string
+		var run []string
+		consider := func(candidate []string) {
+			if betterLiterals(candidate, best) {
+				best = candidate
+			}
+		}
+		for _, sub := range re.Sub {
+			if exact := exactLiterals(sub); exact != nil {
+				if run == nil {
+					run = exact
+				} else {
+					run = crossProduct(run, exact)
+				}
+				if run != nil {
+					consider(run)
+					continue
+				}
+			}
+			run = nil
+			consider(requiredLiterals(sub))
+		}
+		return best
+	}
+	return nil
+}
+
+// returns every string the expression can match when that set is small and finite
+func exactLiterals(re *syntax.Regexp) []string {
+	switch re.Op {
+	case syntax.OpEmptyMatch, syntax.OpBeginLine, syntax.OpEndLine, syntax.OpBeginText, syntax.OpEndText,
+		syntax.OpWordBoundary, syntax.OpNoWordBoundary:
+		return []string{""}
+	case syntax.OpLiteral:
+		return []string{strings.ToLower(string(re.Rune))}
+	case syntax.OpCharClass:
+		var literals []string
+		for i := 0; i+1 < len(re.Rune); i += 2 {
+			if re.Rune[i+1]-re.Rune[i] >= maxKeywordCount {
+				return nil
+			}
+			for r := re.Rune[i]; r <= re.Rune[i+1]; r++ {
+				literals = append(literals, string(unicode.ToLower(r)))
+			}
+		}
+		literals = dedupe(literals)
+		//signatures write alternatives within a class as [x|X], where the pipe is not meant to be matched
+		if len(literals) > 1 {
+			literals = withoutPipe(literals)
+		}
+		if len(literals) > maxKeywordCount {
+			return nil
+		}
+		return literals
+	case syntax.OpCapture:
+		return exactLiterals(re.Sub[0])
+	case syntax.OpConcat:
+		literals := []string{""}
+		for _, sub := range re.Sub {
+			exact := exactLiterals(sub)
+			if exact == nil {
+				return nil
+			}
+			if literals = crossProduct(literals, exact); literals == nil {
+				return nil
+			}
+		}
+		return literals
+	case syntax.OpAlternate:
+		var literals []string
+		for _, sub := range re.Sub {
+			exact := exactLiterals(sub)
+			if exact == nil {
+				return nil
+			}
+			literals = append(literals, exact...)
+		}
+		literals = dedupe(literals)
+		if len(literals) > maxKeywordCount {
+			return nil
+		}
+		return literals
+	}
+	return nil
+}
+
+func crossProduct(prefixes []string, suffixes []string) []string {
+	if len(prefixes)*len(suffixes) > maxKeywordCount {
+		return nil
+	}
+	var product []string
+	for _, prefix := range prefixes {
+		for _, suffix := range suffixes {
+			product = append(product, prefix+suffix)
+		}
+	}
+	return dedupe(product)
+}
+
+// prefers sets whose shortest literal is longest, then sets with fewer literals
+func betterLiterals(candidate []string, best []string) bool {
+	if len(candidate) == 0 || len(candidate) > maxKeywordCount {
+		return false
+	}
+	if best == nil {
+		return shortestLength(candidate) > 0
+	}
+	candidateLength, bestLength := shortestLength(candidate), shortestLength(best)
+	if candidateLength != bestLength {
+		return candidateLength > bestLength
+	}
+	return len(candidate) < len(best)
+}
+
+func shortestLength(literals []string) int {
+	shortest := -1
+	for _, literal := range literals {
+		if shortest == -1 || len(literal) < shortest {
+			shortest = len(literal)
+		}
+	}
+	return shortest
+}
+
+func dedupe(literals []string) []string {
+	seen := make(map[string]bool)
+	var unique []string
+	for _, literal := range literals {
+		if !seen[literal] {
+			seen[literal] = true
+			unique = append(unique, literal)
+		}
+	}
+	return unique
+}
+
+func withoutPipe(literals []string) []string {
+	var kept []string
+	for _, literal := range literals {
+		if literal != "|" {
+			kept = append(kept, literal)
+		}
+	}
+	return kept
+}
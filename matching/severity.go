@@ -0,0 +1,44 @@
+package matching
+
+import (
+	"errors"
+	"fmt"
+)
+
+const (
+	SeverityLow      = "low"
+	SeverityMedium   = "medium"
+	SeverityHigh     = "high"
+	SeverityCritical = "critical"
+	DefaultSeverity  = SeverityMedium
+)
+
+var severityRanks = map[string]int{
+	SeverityLow:      1,
+	SeverityMedium:   2,
+	SeverityHigh:     3,
+	SeverityCritical: 4,
+}
+
+// ranks a severity for comparison; findings recorded without one rank as the default severity
+func SeverityRank(severity string) int {
+	if severity == "" {
+		severity = DefaultSeverity
+	}
+	return severityRanks[severity]
+}
+
+func ValidateSeverity(severity string) error {
+	if _, ok := severityRanks[severity]; !ok {
+		return errors.New(fmt.Sprintf("Unrecognized severity: %s (expected low, medium, high or critical)", severity))
+	}
+	return nil
+}
+
+// returns the higher of two severities, ignoring an empty one such as that of a placeholder signature
+func HighestSeverity(severity string, other string) string {
+	if severity == "" || (other != "" && SeverityRank(other) > SeverityRank(severity)) {
+		return other
+	}
+	return severity
+}
@@ -11,6 +11,7 @@ import (
 type Signatures struct {
 	FileSignatures    []FileSignature
 	ContentSignatures []ContentSignature
+	prefilter         *prefilter
 }
 
 func (s *Signatures) loadSignatures(path string) error {
@@ -42,5 +43,35 @@ func (s *Signatures) Load(mode int) error {
 			return e
 		}
 	}
+	return s.compile()
+}
+
+// compiles every pattern once up front so matching never recompiles them per file
+func (s *Signatures) compile() error {
+	for i := range s.FileSignatures {
+		if err := s.FileSignatures[i].compile(); err != nil {
+			return errors.New(fmt.Sprintf("Invalid file signature '%s': %s\n", s.FileSignatures[i].Description, err))
+		}
+	}
+	for i := range s.ContentSignatures {
+		if err := s.ContentSignatures[i].compile(); err != nil {
+			return errors.New(fmt.Sprintf("Invalid content signature '%s': %s\n", s.ContentSignatures[i].Description, err))
+		}
+	}
+	s.prefilter = newPrefilter(s.ContentSignatures)
 	return nil
 }
+
+// returns the content signatures whose required keywords occur in the content
+func (s *Signatures) ContentSignaturesFor(content string) []ContentSignature {
+	if s.prefilter == nil {
+		return s.ContentSignatures
+	}
+	var candidates []ContentSignature
+	for i, candidate := range s.prefilter.candidates(content) {
+		if candidate {
+			candidates = append(candidates, s.ContentSignatures[i])
+		}
+	}
+	return candidates
+}
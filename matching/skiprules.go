@@ -0,0 +1,180 @@
+package matching
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"strings"
+
+	"github.com/codeEmitter/gitrob/common"
+)
+
+const (
+	SkipReasonExtension = "extension"
+	SkipReasonPath      = "path"
+	SkipReasonPattern   = "pattern"
+	SkipReasonSize      = "size"
+	SkipReasonBinary    = "binary"
+)
+
+// rules deciding which files are not inspected: extensions, path globs and path regular expressions
+// apply to every file, while the size limit and binary sniff only apply to content matching, so that
+// file signatures still find binary files such as key stores
+type SkipRuleSet struct {
+	Extensions  []string
+	Paths       []string
+	Patterns    []string
+	MaxFileSize *int64
+	SkipBinary  *bool
+
+	paths    []*regexp.Regexp
+	patterns []*regexp.Regexp
+}
+
+// a skip rule set added to the default rules for repositories whose owner/name matches a glob;
+// its lists extend the default ones and its size limit and binary sniff replace them when set
+type RepositorySkipRules struct {
+	Repository string
+	SkipRuleSet
+
+	glob *regexp.Regexp
+}
+
+type SkipRules struct {
+	SkipRuleSet
+	Repositories []*RepositorySkipRules
+}
+
+func DefaultSkipRules() *SkipRules {
+	maxFileSize := int64(0)
+	skipBinary := true
+	rules := &SkipRules{
+		SkipRuleSet: SkipRuleSet{
+			Extensions:  []string{".jpg", ".jpeg", ".png", ".gif", ".bmp", ".tiff", ".tif", ".psd", ".xcf"},
+			Paths:       []string{"**/node_modules/**", "**/vendor/bundle/**", "**/vendor/cache/**"},
+			MaxFileSize: &maxFileSize,
+			SkipBinary:  &skipBinary,
+		},
+	}
+	if err := rules.compile(); err != nil {
+		panic(fmt.Sprintf("Invalid default skip rules: %s", err))
+	}
+	return rules
+}
+
+// loads skip rules from a JSON file, where rules that are left out keep their defaults
+func LoadSkipRules(path string) (*SkipRules, error) {
+	if !common.FileExists(path) {
+		return nil, errors.New(fmt.Sprintf("Missing skip rules file: %s.", path))
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	rules := DefaultSkipRules()
+	if err := json.Unmarshal(data, rules); err != nil {
+		return nil, errors.New(fmt.Sprintf("Invalid skip rules file %s: %s", path, err))
+	}
+	if err := rules.compile(); err != nil {
+		return nil, errors.New(fmt.Sprintf("Invalid skip rules file %s: %s", path, err))
+	}
+	return rules, nil
+}
+
+func (r *SkipRules) compile() error {
+	if err := r.SkipRuleSet.compile(); err != nil {
+		return err
+	}
+	for _, repositoryRules := range r.Repositories {
+		glob, err := CompileGlob(repositoryRules.Repository)
+		if err != nil {
+			return errors.New(fmt.Sprintf("repository '%s': %s", repositoryRules.Repository, err))
+		}
+		repositoryRules.glob = glob
+		if err := repositoryRules.SkipRuleSet.compile(); err != nil {
+			return errors.New(fmt.Sprintf("repository '%s': %s", repositoryRules.Repository, err))
+		}
+	}
+	return nil
+}
+
+func (r *SkipRuleSet) compile() error {
+	r.paths = nil
+	r.patterns = nil
+	for _, path := range r.Paths {
+		//paths are skipped regardless of case, like extensions
+		glob, err := CompileGlobIgnoringCase(path)
+		if err != nil {
+			return errors.New(fmt.Sprintf("path '%s': %s", path, err))
+		}
+		r.paths = append(r.paths, glob)
+	}
+	for _, pattern := range r.Patterns {
+		regex, err := regexp.Compile(pattern)
+		if err != nil {
+			return errors.New(fmt.Sprintf("pattern '%s': %s", pattern, err))
+		}
+		r.patterns = append(r.patterns, regex)
+	}
+	return nil
+}
+
+// returns the rules applying to a repository, i.e. the default rules combined with its overrides
+func (r *SkipRules) For(owner string, name string) *SkipRuleSet {
+	rules := r.SkipRuleSet
+	for _, repositoryRules := range r.Repositories {
+		if !repositoryRules.glob.MatchString(owner + "/" + name) {
+			continue
+		}
+		rules.Extensions = append(append([]string{}, rules.Extensions...), repositoryRules.Extensions...)
+		rules.paths = append(append([]*regexp.Regexp{}, rules.paths...), repositoryRules.paths...)
+		rules.patterns = append(append([]*regexp.Regexp{}, rules.patterns...), repositoryRules.patterns...)
+		if repositoryRules.MaxFileSize != nil {
+			rules.MaxFileSize = repositoryRules.MaxFileSize
+		}
+		if repositoryRules.SkipBinary != nil {
+			rules.SkipBinary = repositoryRules.SkipBinary
+		}
+	}
+	return &rules
+}
+
+// returns the reason a file is skipped based on its path, or an empty string when it is inspected
+func (r *SkipRuleSet) PathSkipReason(target MatchTarget) string {
+	for _, extension := range r.Extensions {
+		if strings.EqualFold(target.Extension, extension) {
+			return SkipReasonExtension
+		}
+	}
+	for _, path := range r.paths {
+		if path.MatchString(target.Path) {
+			return SkipReasonPath
+		}
+	}
+	for _, pattern := range r.patterns {
+		if pattern.MatchString(target.Path) {
+			return SkipReasonPattern
+		}
+	}
+	return ""
+}
+
+// returns the reason the content of a file is not matched, or an empty string when it is matched;
+// isBinary is only called when binary files are skipped, and after the size limit, since sniffing reads the file
+func (r *SkipRuleSet) ContentSkipReason(size int64, isBinary func() (bool, error)) (string, error) {
+	if r.MaxFileSize != nil && *r.MaxFileSize > 0 && size > *r.MaxFileSize {
+		return SkipReasonSize, nil
+	}
+	if r.SkipBinary != nil && *r.SkipBinary {
+		binary, err := isBinary()
+		if err != nil {
+			return "", err
+		}
+		if binary {
+			return SkipReasonBinary, nil
+		}
+	}
+	return "", nil
+}
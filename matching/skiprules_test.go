@@ -0,0 +1,43 @@
+package matching
+
+import "testing"
+
+func TestDefaultSkipRulesPathSkipReason(t *testing.T) {
+	rules := DefaultSkipRules()
+	tests := []struct {
+		path   string
+		reason string
+	}{
+		{"node_modules/left-pad/index.js", SkipReasonPath},
+		{"web/Node_Modules/left-pad/index.js", SkipReasonPath},
+		{"vendor/bundle/ruby/config.yml", SkipReasonPath},
+		{"docs/logo.PNG", SkipReasonExtension},
+		{"config/node_modules.yml", ""},
+		{"id_rsa", ""},
+	}
+	for _, test := range tests {
+		if reason := rules.PathSkipReason(NewMatchTarget(test.path)); reason != test.reason {
+			t.Errorf("PathSkipReason(%q) = %q, want %q", test.path, reason, test.reason)
+		}
+	}
+}
+
+func TestContentSkipReasonSniffsBinaryFilesWithinTheSizeLimit(t *testing.T) {
+	maxFileSize := int64(100)
+	rules := DefaultSkipRules().For("acme", "alpha")
+	rules.MaxFileSize = &maxFileSize
+	sniffed := 0
+	isBinary := func() (bool, error) {
+		sniffed++
+		return true, nil
+	}
+	if reason, err := rules.ContentSkipReason(200, isBinary); err != nil || reason != SkipReasonSize {
+		t.Errorf("ContentSkipReason(200) = %q, %v, want %q", reason, err, SkipReasonSize)
+	}
+	if sniffed != 0 {
+		t.Errorf("files over the size limit were sniffed")
+	}
+	if reason, err := rules.ContentSkipReason(50, isBinary); err != nil || reason != SkipReasonBinary {
+		t.Errorf("ContentSkipReason(50) = %q, %v, want %q", reason, err, SkipReasonBinary)
+	}
+}
@@ -53,8 +53,36 @@ var Finding = Backbone.Model.extend({
         }
         return false;
     },
+    isNew: function () {
+        var fingerprint = this.get("Fingerprint");
+        if (!fingerprint || !findingsView.baseline) {
+            return false;
+        }
+        return !_.contains(findingsView.baseline, fingerprint);
+    },
+    occurrences: function () {
+        var fingerprint = this.get("Fingerprint");
+        if (!fingerprint || !this.collection) {
+            return [this];
+        }
+        return this.collection.where({"Fingerprint": fingerprint});
+    },
+    firstSeen: function () {
+        return _.min(this.occurrences(), function (finding) {
+            return Date.parse(finding.get("CommitDate"));
+        });
+    },
+    lastSeen: function () {
+        return _.max(this.occurrences(), function (finding) {
+            return Date.parse(finding.get("CommitDate"));
+        });
+    },
     fileContentsUrl: function () {
-        return ["/files", this.get("RepositoryOwner"), this.get("RepositoryName"), this.get("CommitHash"), this.get("FilePath")].join("/");
+        //local findings link to their file with the repository it is in
+        if (this.get("FileUrl").indexOf("/files/") === 0) return this.get("FileUrl");
+        var url = ["/files", this.get("RepositoryOwner"), this.get("RepositoryName"), this.get("CommitHash"), this.get("FilePath")].join("/");
+        if (this.get("SourceType")) url += "?sourceType=" + encodeURIComponent(this.get("SourceType"));
+        return url;
     },
     fileContents: function (callback, error) {
         $.ajax({
@@ -68,6 +96,43 @@ var Finding = Backbone.Model.extend({
 var Findings = Backbone.Collection.extend({
     url: "/findings",
     model: Finding,
+    pageSize: 500,
+    initialize: function () {
+        this.offsets = {"/findings": 0, "/suppressed": 0};
+        this.fetching = false;
+        this.fetchAgain = false;
+    },
+    //findings are only ever added, so each poll fetches the pages recorded since the last one
+    fetchNew: function () {
+        if (this.fetching) {
+            this.fetchAgain = true;
+            return;
+        }
+        this.fetching = true;
+        this.fetchPages("/findings", _.bind(function () {
+            this.fetchPages("/suppressed", _.bind(function () {
+                this.fetching = false;
+                if (this.fetchAgain) {
+                    this.fetchAgain = false;
+                    this.fetchNew();
+                }
+            }, this));
+        }, this));
+    },
+    fetchPages: function (url, done) {
+        var collection = this;
+        $.getJSON(url, {offset: this.offsets[url], limit: this.pageSize}, function (page) {
+            collection.offsets[url] += page.length;
+            collection.add(page);
+            if (page.length === collection.pageSize) {
+                collection.fetchPages(url, done);
+            } else {
+                done();
+            }
+        }).fail(function () {
+            done();
+        });
+    },
 });
 
 window.findings = new Findings();
@@ -229,9 +294,12 @@ var FindingView = Backbone.View.extend({
 
 var FindingsView = Backbone.View.extend({
     collection: findings,
+    baseline: null,
     initialize: function () {
+        this.findingViews = {};
+        this.fetchBaseline();
         this.listenTo(this.collection, "add", this.renderFinding);
-        this.listenTo(stats, "change:Findings", _.debounce(this.update, 500));
+        this.listenTo(stats, "change:Findings change:Suppressed", _.debounce(this.update, 500));
         $("#findings_search").on("keyup", _.debounce(this.searchFindings, 200));
         $("#finding_modal").on("show.bs.modal", function (event) {
             $(document).on("keydown", function (e) {
@@ -264,11 +332,30 @@ var FindingsView = Backbone.View.extend({
             });
     },
     update: function () {
-        this.collection.fetch();
+        this.collection.fetchNew();
+    },
+    fetchBaseline: function () {
+        $.getJSON("/baseline", function (fingerprints) {
+            //without a baseline session there is nothing to compare findings to
+            if (fingerprints === null) {
+                return;
+            }
+            findingsView.baseline = fingerprints;
+            _.invoke(findingsView.findingViews, "render");
+        });
     },
     renderFinding: function (finding) {
-        var findingEl = new FindingView({model: finding}).render().el;
-        $(findingEl).appendTo(this.$el);
+        //one row per secret, further occurrences only update the row's occurrence count
+        var fingerprint = finding.get("Fingerprint");
+        if (fingerprint && this.findingViews[fingerprint]) {
+            this.findingViews[fingerprint].render();
+            return;
+        }
+        var findingView = new FindingView({model: finding});
+        if (fingerprint) {
+            this.findingViews[fingerprint] = findingView;
+        }
+        $(findingView.render().el).appendTo(this.$el);
     },
     activeFinding: function () {
         return this.$el.find("tr.table-selected");
@@ -299,6 +386,34 @@ var FindingsView = Backbone.View.extend({
 });
 window.findingsView = new FindingsView({el: "#table_findings tbody"});
 
+//the secrets of the baseline session no longer found, which are only known once analysis finishes
+var ResolvedView = Backbone.View.extend({
+    template: _.template($("#template_resolved").html()),
+    initialize: function () {
+        this.listenTo(stats, "change:Status", this.update);
+    },
+    update: function () {
+        if (!stats.isFinished()) {
+            return;
+        }
+        $.getJSON("/diff", _.bind(this.render, this));
+    },
+    render: function (diff) {
+        if (!diff.Baseline) {
+            return this;
+        }
+        var tbody = this.$("tbody").empty();
+        _.each(diff.Resolved, function (group) {
+            $("<tr>").html(this.template(group)).appendTo(tbody);
+        }, this);
+        this.$("#resolved_desc").text(diff.Resolved.length + " of the secrets found by " + diff.Baseline + " were not found again, and " + diff.New.length + " secrets are new.");
+        this.$el.show();
+        return this;
+    },
+});
+
+window.resolvedView = new ResolvedView({el: "#page_resolved"});
+
 var FindingModal = Backbone.View.extend({
     template: _.template($("#template_finding_modal").html()),
     interestingStringPatterns: [
@@ -336,8 +451,21 @@ var FindingModal = Backbone.View.extend({
         $("#modal_file_hexdump").show();
     },
     getHostName: function () {
-        if (this.model.get("CommitUrl").indexOf("github") !== -1) return "Github";
-        return "GitLab";
+        var sourceType = this.model.get("SourceType");
+        if (sourceType === "Local") return "disk";
+        if (sourceType) return sourceType;
+        if (this.model.get("FileUrl").indexOf("/files/") === 0) return "disk";
+        if (this.model.get("CommitUrl").indexOf("/-/commit/") !== -1) return "GitLab";
+        return "Github";
+    },
+    formattedLineNumber: function () {
+        if (this.model.get("Removed")) {
+            return this.model.get("OldLineNumber") + " (removed in this commit)";
+        }
+        if (this.model.get("LineNumber")) {
+            return this.model.get("LineNumber");
+        }
+        return this.model.get("OldLineNumber") + " (old file)";
     },
     truncatedCommitMessage: function () {
         var message = this.model.trimmedCommitMessage();
@@ -358,6 +486,45 @@ var FindingModal = Backbone.View.extend({
         });
         return haystack;
     },
+    trackOpenTags: function (line, openTags) {
+        var pattern = /<(\/?)([a-zA-Z]+)[^>]*>/g;
+        var match;
+        while ((match = pattern.exec(line)) !== null) {
+            if (match[1] === "/") {
+                openTags.pop();
+            } else {
+                openTags.push({name: match[2], tag: match[0]});
+            }
+        }
+        return openTags;
+    },
+    openingTags: function (openTags) {
+        return _.map(openTags, function (t) { return t.tag; }).join("");
+    },
+    closingTags: function (openTags) {
+        return _.map(openTags.slice().reverse(), function (t) { return "</" + t.name + ">"; }).join("");
+    },
+    highlightFindingLine: function (html) {
+        var lineNumber = this.model.get("LineNumber");
+        var lines = html.split("\n");
+        if (!lineNumber || lineNumber > lines.length) {
+            return html;
+        }
+        var before = [];
+        for (var i = 0; i < lineNumber - 1; i++) {
+            this.trackOpenTags(lines[i], before);
+        }
+        var after = this.trackOpenTags(lines[lineNumber - 1], before.slice());
+        lines[lineNumber - 1] = this.closingTags(before) + "<span class='finding-line'>" + this.openingTags(before) +
+            lines[lineNumber - 1] + this.closingTags(after) + "</span>" + this.openingTags(after);
+        return lines.join("\n");
+    },
+    scrollToFindingLine: function () {
+        var line = $("#modal_file_contents .finding-line");
+        if (line.length > 0) {
+            line[0].scrollIntoView({block: "center"});
+        }
+    },
     fetchFileContents: function () {
         if (this.model.get("Action") == "Delete") {
             var content = "<div class='alert alert-info' role='alert'>View commit on %s to see contents of deleted files.</div>";
@@ -374,7 +541,7 @@ var FindingModal = Backbone.View.extend({
             var worker = new Worker("/javascripts/highlight_worker.js");
             worker.onmessage = function (event) {
                 $("#modal_file_spinner_container").fadeOut("fast", _.bind(function () {
-                    var content = this.highlightInterestingStrings(event.data);
+                    var content = this.highlightFindingLine(this.highlightInterestingStrings(event.data));
                     $("#modal_file_contents").html(content);
                     new Hexdump(data, {
                         container: "modal_file_hexdump",
@@ -401,6 +568,7 @@ var FindingModal = Backbone.View.extend({
                         this.showHexDumpContents();
                     } else {
                         this.showRawContents();
+                        this.scrollToFindingLine();
                     }
                 }, context));
             };
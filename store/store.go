@@ -0,0 +1,161 @@
+package store
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	bolt "go.etcd.io/bbolt"
+)
+
+const (
+	BucketSession       = "session"
+	BucketTargets       = "targets"
+	BucketRepositories  = "repositories"
+	BucketFindings      = "findings"
+	BucketFindingGroups = "findinggroups"
+	BucketCheckpoints   = "checkpoints"
+	BucketSuppressed    = "suppressed"
+	OpenTimeout         = 1 * time.Second
+)
+
+var buckets = []string{
+	BucketSession,
+	BucketTargets,
+	BucketRepositories,
+	BucketFindings,
+	BucketFindingGroups,
+	BucketCheckpoints,
+	BucketSuppressed,
+}
+
+// an embedded key/value database of JSON encoded values, grouped in buckets
+type Store struct {
+	db *bolt.DB
+}
+
+type Tx struct {
+	tx *bolt.Tx
+}
+
+func Open(path string) (*Store, error) {
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: OpenTimeout})
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("Unable to open database %s: %s", path, err))
+	}
+	err = db.Update(func(tx *bolt.Tx) error {
+		for _, bucket := range buckets {
+			if _, err := tx.CreateBucketIfNotExists([]byte(bucket)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	return &Store{db: db}, nil
+}
+
+// opens an existing database for reading only, such as that of another session, which may be open
+// for reading elsewhere at the same time
+func OpenReadOnly(path string) (*Store, error) {
+	db, err := bolt.Open(path, 0600, &bolt.Options{Timeout: OpenTimeout, ReadOnly: true})
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("Unable to open database %s: %s", path, err))
+	}
+	return &Store{db: db}, nil
+}
+
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+func (s *Store) Update(fn func(tx Tx) error) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		return fn(Tx{tx: tx})
+	})
+}
+
+// like Update, but combines the calls of concurrent goroutines into a single transaction, so fn may be
+// called more than once and must not change anything outside of the transaction
+func (s *Store) Batch(fn func(tx Tx) error) error {
+	return s.db.Batch(func(tx *bolt.Tx) error {
+		return fn(Tx{tx: tx})
+	})
+}
+
+func (s *Store) View(fn func(tx Tx) error) error {
+	return s.db.View(func(tx *bolt.Tx) error {
+		return fn(Tx{tx: tx})
+	})
+}
+
+func (t Tx) Put(bucket string, key string, value interface{}) error {
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	return t.tx.Bucket([]byte(bucket)).Put([]byte(key), data)
+}
+
+// decodes the value stored under the key into value and reports whether it was found
+func (t Tx) Get(bucket string, key string, value interface{}) (bool, error) {
+	b := t.tx.Bucket([]byte(bucket))
+	if b == nil {
+		return false, nil
+	}
+	data := b.Get([]byte(key))
+	if data == nil {
+		return false, nil
+	}
+	return true, json.Unmarshal(data, value)
+}
+
+// stores the value under the bucket's next sequence number, preserving insertion order
+func (t Tx) Append(bucket string, value interface{}) error {
+	b := t.tx.Bucket([]byte(bucket))
+	sequence, err := b.NextSequence()
+	if err != nil {
+		return err
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, sequence)
+	return b.Put(key, data)
+}
+
+// calls fn with the JSON encoded value of every key of the bucket, in key order
+func (t Tx) ForEach(bucket string, fn func(data []byte) error) error {
+	b := t.tx.Bucket([]byte(bucket))
+	if b == nil {
+		//databases opened read only lack the buckets added by later versions
+		return nil
+	}
+	return b.ForEach(func(key []byte, data []byte) error {
+		return fn(data)
+	})
+}
+
+// calls fn with the JSON encoded values of at most limit keys of the bucket, in key order, after skipping
+// the first offset keys, or with every value from the offset on when limit is not positive
+func (t Tx) Page(bucket string, offset int, limit int, fn func(data []byte) error) error {
+	cursor := t.tx.Bucket([]byte(bucket)).Cursor()
+	key, data := cursor.First()
+	for i := 0; key != nil && i < offset; i++ {
+		key, data = cursor.Next()
+	}
+	for i := 0; key != nil && (limit <= 0 || i < limit); i++ {
+		if err := fn(data); err != nil {
+			return err
+		}
+		key, data = cursor.Next()
+	}
+	return nil
+}